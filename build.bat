@@ -22,7 +22,7 @@ IF "%VERSION%"=="" (
 )
 
 REM Define the image name
-SET IMAGE_NAME=user-service
+SET IMAGE_NAME=spotify-service
 
 REM Build the Docker image
 echo Building Docker image with version %VERSION%...
@@ -16,10 +16,11 @@ func InitArtistClient(addr string) {
 	if err != nil {
 		panic(err)
 	}
+	conns = append(conns, cc)
 	artistClient = proto.NewArtistServiceClient(cc)
 }
 
 // CreateArtist creates a new artist.
-func CreateArtist(artist *proto.Artist) (*proto.Id, error) {
-	return artistClient.CreateArtist(context.Background(), artist)
+func CreateArtist(ctx context.Context, artist *proto.Artist) (*proto.Id, error) {
+	return artistClient.CreateArtist(ctx, artist)
 }
@@ -0,0 +1,18 @@
+package clients
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// conns holds the connections of all initialized clients, so they can be closed on shutdown.
+var conns []*grpc.ClientConn
+
+// Close closes the connections of all initialized clients.
+func Close() {
+	for _, cc := range conns {
+		if err := cc.Close(); err != nil {
+			zap.L().Error("Failed to close client connection", zap.String("target", cc.Target()), zap.Error(err))
+		}
+	}
+}
@@ -2,12 +2,15 @@ package clients
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/Fan-Fuse/config-service/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 var configClient proto.ConfigServiceClient
@@ -17,11 +20,15 @@ type configEntry struct {
 	Value string
 }
 
+// configMu guards the values in Config, which are updated by subscribeToKeys while they are read.
+var configMu sync.RWMutex
+
 // We are defining the keys this service wants here
 var Config = []configEntry{
 	{Key: "APP_ENV", Value: ""},
 	{Key: "APP_VERSION", Value: ""},
 	{Key: "USER_REGISTRATION_OPEN", Value: ""},
+	{Key: "SPOTIFY_ALBUM_GROUPS", Value: ""}, // Comma separated, e.g. "album,single,compilation,appears_on"
 }
 
 // NewConfigServiceClient creates a new ConfigServiceClient.
@@ -30,6 +37,7 @@ func InitConfig(addr string) {
 	if err != nil {
 		panic(err)
 	}
+	conns = append(conns, cc)
 	configClient = proto.NewConfigServiceClient(cc)
 
 	// Wait for the connection to be established
@@ -58,14 +66,23 @@ func waitForConfigService() {
 func getKeys() {
 	for i := range Config {
 		resp, err := configClient.GetKey(context.Background(), &proto.GetKeyRequest{Key: Config[i].Key})
+		if status.Code(err) == codes.NotFound {
+			// Optional keys don't have to be configured, they keep their empty default
+			zap.L().Warn("Key not configured", zap.String("key", Config[i].Key))
+			continue
+		}
 		if err != nil {
-			zap.S().Fatal("Error getting key", zap.String("key", Config[i].Key))
+			zap.L().Fatal("Error getting key", zap.String("key", Config[i].Key), zap.Error(err))
 		}
+		configMu.Lock()
 		Config[i].Value = resp.Value // Modify the actual element in the Config slice
+		configMu.Unlock()
 	}
 }
 
 func GetKey(key string) string {
+	configMu.RLock()
+	defer configMu.RUnlock()
 	for i := range Config {
 		if Config[i].Key == key {
 			return Config[i].Value
@@ -77,7 +94,7 @@ func GetKey(key string) string {
 // subscribeToKeys subscribes to the keys in a background goroutine, updating the Config slice
 func subscribeToKeys() {
 	stream, err := configClient.Subscribe(context.Background(), &proto.SubscribeRequest{
-		Keys: []string{"APP_ENV", "APP_VERSION", "USER_REGISTRATION_OPEN"},
+		Keys: []string{"APP_ENV", "APP_VERSION", "USER_REGISTRATION_OPEN", "SPOTIFY_ALBUM_GROUPS"},
 	})
 	if err != nil {
 		zap.S().Fatal("Error subscribing to keys")
@@ -85,16 +102,22 @@ func subscribeToKeys() {
 
 	for {
 		resp, err := stream.Recv()
+		if status.Code(err) == codes.Canceled {
+			// The connection was closed on shutdown
+			return
+		}
 		if err != nil {
 			zap.S().Fatal("Error receiving key update")
 		}
 
+		configMu.Lock()
 		for i := range Config {
 			// TODO: React to the Key change, eventually fully reloading the service
 			if Config[i].Key == resp.Key {
 				Config[i].Value = resp.Value // Modify the actual element in the Config slice
-				zap.S().Info(zap.String("event", "KEY_UPDATE"), zap.String("value", resp.Key))
+				zap.L().Info("Key updated", zap.String("key", resp.Key))
 			}
 		}
+		configMu.Unlock()
 	}
 }
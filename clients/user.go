@@ -16,6 +16,7 @@ func InitUserClient(addr string) {
 	if err != nil {
 		panic(err)
 	}
+	conns = append(conns, cc)
 	userClient = proto.NewUserServiceClient(cc)
 }
 
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Routing keys of the events this service publishes.
+const (
+	ArtistSynced   = "spotify.artist.synced"
+	UserSynced     = "spotify.user.synced"
+	UserSyncFailed = "spotify.user.sync_failed"
+	NewRelease     = "spotify.release.new"
+)
+
+// Publisher publishes a message to an exchange.
+type Publisher interface {
+	Publish(ctx context.Context, exchange string, routingKey string, body []byte) error
+}
+
+var (
+	publisher Publisher
+	exchange  string
+)
+
+// ArtistSyncedEvent is published after an artist was synced to the artist-service.
+type ArtistSyncedEvent struct {
+	ArtistID      string    `json:"artist_id"`  // This is a fanfuse artist id
+	SpotifyID     string    `json:"spotify_id"` // This is a spotify artist id
+	Albums        int       `json:"albums"`
+	AddedAlbums   int       `json:"added_albums"`
+	RemovedAlbums int       `json:"removed_albums"`
+	DurationMs    int64     `json:"duration_ms"`
+	SyncedAt      time.Time `json:"synced_at"`
+}
+
+// NewReleaseEvent is published for every album that appeared since the previous sync of an artist.
+// On the first sync of an artist, e.g. after the sync state was lost, recently released albums are published again,
+// so consumers should deduplicate by SpotifyAlbumID.
+type NewReleaseEvent struct {
+	ArtistID       string    `json:"artist_id"`        // This is a fanfuse artist id
+	SpotifyID      string    `json:"spotify_id"`       // This is a spotify artist id
+	SpotifyAlbumID string    `json:"spotify_album_id"` // This is a spotify album id
+	Name           string    `json:"name"`
+	AlbumType      string    `json:"album_type"`
+	AlbumGroup     string    `json:"album_group"` // "appears_on" if the artist is only featured on the album
+	DetectedAt     time.Time `json:"detected_at"`
+
+	// ReleaseDate is formatted as YYYY, YYYY-MM or YYYY-MM-DD depending on the precision ("year", "month" or "day").
+	// Both are empty if Spotify doesn't know the release date.
+	ReleaseDate          string `json:"release_date,omitempty"`
+	ReleaseDatePrecision string `json:"release_date_precision,omitempty"`
+}
+
+// UserSyncEvent is published after the artists of a user were synced, as UserSynced or UserSyncFailed.
+type UserSyncEvent struct {
+	UserID     string    `json:"user_id"` // This is a fanfuse user id
+	Succeeded  int       `json:"succeeded"`
+	Failed     int       `json:"failed"`
+	Skipped    int       `json:"skipped"`
+	Fresh      int       `json:"fresh"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	SyncedAt   time.Time `json:"synced_at"`
+}
+
+// Init sets the publisher and the topic exchange events are published to.
+func Init(p Publisher, exchangeName string) {
+	publisher = p
+	exchange = exchangeName
+}
+
+// Publish publishes an event as JSON with the given routing key. It does nothing if Init wasn't called.
+func Publish(ctx context.Context, routingKey string, event any) error {
+	if publisher == nil {
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return publisher.Publish(ctx, exchange, routingKey, body)
+}
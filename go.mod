@@ -8,8 +8,10 @@ require (
 	github.com/Fan-Fuse/user-service v0.0.0-20240709024251-7e60dd68c16c
 	github.com/rabbitmq/amqp091-go v1.10.0
 	github.com/zmb3/spotify/v2 v2.4.2
+	go.etcd.io/bbolt v1.3.10
 	go.uber.org/zap v1.27.0
 	golang.org/x/oauth2 v0.20.0
+	golang.org/x/sync v0.7.0
 	google.golang.org/grpc v1.65.0
 	google.golang.org/protobuf v1.34.2
 )
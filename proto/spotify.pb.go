@@ -20,15 +20,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReleaseDatePrecision int32
+
+const (
+	ReleaseDatePrecision_RELEASE_DATE_PRECISION_UNKNOWN ReleaseDatePrecision = 0
+	ReleaseDatePrecision_RELEASE_DATE_PRECISION_YEAR    ReleaseDatePrecision = 1
+	ReleaseDatePrecision_RELEASE_DATE_PRECISION_MONTH   ReleaseDatePrecision = 2
+	ReleaseDatePrecision_RELEASE_DATE_PRECISION_DAY     ReleaseDatePrecision = 3
+)
+
+// Enum value maps for ReleaseDatePrecision.
+var (
+	ReleaseDatePrecision_name = map[int32]string{
+		0: "RELEASE_DATE_PRECISION_UNKNOWN",
+		1: "RELEASE_DATE_PRECISION_YEAR",
+		2: "RELEASE_DATE_PRECISION_MONTH",
+		3: "RELEASE_DATE_PRECISION_DAY",
+	}
+	ReleaseDatePrecision_value = map[string]int32{
+		"RELEASE_DATE_PRECISION_UNKNOWN": 0,
+		"RELEASE_DATE_PRECISION_YEAR":    1,
+		"RELEASE_DATE_PRECISION_MONTH":   2,
+		"RELEASE_DATE_PRECISION_DAY":     3,
+	}
+)
+
+func (x ReleaseDatePrecision) Enum() *ReleaseDatePrecision {
+	p := new(ReleaseDatePrecision)
+	*p = x
+	return p
+}
+
+func (x ReleaseDatePrecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReleaseDatePrecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_spotify_proto_enumTypes[0].Descriptor()
+}
+
+func (ReleaseDatePrecision) Type() protoreflect.EnumType {
+	return &file_proto_spotify_proto_enumTypes[0]
+}
+
+func (x ReleaseDatePrecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReleaseDatePrecision.Descriptor instead.
+func (ReleaseDatePrecision) EnumDescriptor() ([]byte, []int) {
+	return file_proto_spotify_proto_rawDescGZIP(), []int{0}
+}
+
 type SpotifyArtist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name   string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Images []*SpotifyImage `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
-	Genres []string        `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
+	Id         string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Images     []*SpotifyImage `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	Genres     []string        `protobuf:"bytes,4,rep,name=genres,proto3" json:"genres,omitempty"`
+	Popularity int32           `protobuf:"varint,5,opt,name=popularity,proto3" json:"popularity,omitempty"` // 0 to 100, based on recent plays
+	Followers  int64           `protobuf:"varint,6,opt,name=followers,proto3" json:"followers,omitempty"`
 }
 
 func (x *SpotifyArtist) Reset() {
@@ -91,17 +145,43 @@ func (x *SpotifyArtist) GetGenres() []string {
 	return nil
 }
 
+func (x *SpotifyArtist) GetPopularity() int32 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *SpotifyArtist) GetFollowers() int64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
 type SpotifyRelease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Images      []*SpotifyImage `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
-	ArtistIds   []string        `protobuf:"bytes,4,rep,name=artist_ids,json=artistIds,proto3" json:"artist_ids,omitempty"`
-	ReleaseDate string          `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	Genres      []string        `protobuf:"bytes,6,rep,name=genres,proto3" json:"genres,omitempty"`
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Images               []*SpotifyImage      `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	ArtistIds            []string             `protobuf:"bytes,4,rep,name=artist_ids,json=artistIds,proto3" json:"artist_ids,omitempty"`
+	ReleaseDate          string               `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"` // Formatted as YYYY, YYYY-MM or YYYY-MM-DD depending on the precision, empty if unknown
+	Genres               []string             `protobuf:"bytes,6,rep,name=genres,proto3" json:"genres,omitempty"`
+	ReleaseDatePrecision ReleaseDatePrecision `protobuf:"varint,7,opt,name=release_date_precision,json=releaseDatePrecision,proto3,enum=spotify.ReleaseDatePrecision" json:"release_date_precision,omitempty"`
+	AlbumType            string               `protobuf:"bytes,8,opt,name=album_type,json=albumType,proto3" json:"album_type,omitempty"` // One of "album", "single" or "compilation"
+	TotalTracks          int32                `protobuf:"varint,9,opt,name=total_tracks,json=totalTracks,proto3" json:"total_tracks,omitempty"`
+	AvailableMarkets     []string             `protobuf:"bytes,10,rep,name=available_markets,json=availableMarkets,proto3" json:"available_markets,omitempty"` // ISO 3166-1 alpha-2 country codes
+	// Only set if album enrichment is enabled
+	Upc        string              `protobuf:"bytes,11,opt,name=upc,proto3" json:"upc,omitempty"`
+	Copyrights []*SpotifyCopyright `protobuf:"bytes,12,rep,name=copyrights,proto3" json:"copyrights,omitempty"`
+	Popularity int32               `protobuf:"varint,13,opt,name=popularity,proto3" json:"popularity,omitempty"` // 0 to 100, based on the popularity of the tracks
+	Label      string              `protobuf:"bytes,14,opt,name=label,proto3" json:"label,omitempty"`
+	// The relation of the artist to the release: "album", "single", "compilation" or "appears_on".
+	// appears_on releases are by other artists that the artist is featured on.
+	AlbumGroup string `protobuf:"bytes,15,opt,name=album_group,json=albumGroup,proto3" json:"album_group,omitempty"`
 }
 
 func (x *SpotifyRelease) Reset() {
@@ -178,6 +258,235 @@ func (x *SpotifyRelease) GetGenres() []string {
 	return nil
 }
 
+func (x *SpotifyRelease) GetReleaseDatePrecision() ReleaseDatePrecision {
+	if x != nil {
+		return x.ReleaseDatePrecision
+	}
+	return ReleaseDatePrecision_RELEASE_DATE_PRECISION_UNKNOWN
+}
+
+func (x *SpotifyRelease) GetAlbumType() string {
+	if x != nil {
+		return x.AlbumType
+	}
+	return ""
+}
+
+func (x *SpotifyRelease) GetTotalTracks() int32 {
+	if x != nil {
+		return x.TotalTracks
+	}
+	return 0
+}
+
+func (x *SpotifyRelease) GetAvailableMarkets() []string {
+	if x != nil {
+		return x.AvailableMarkets
+	}
+	return nil
+}
+
+func (x *SpotifyRelease) GetUpc() string {
+	if x != nil {
+		return x.Upc
+	}
+	return ""
+}
+
+func (x *SpotifyRelease) GetCopyrights() []*SpotifyCopyright {
+	if x != nil {
+		return x.Copyrights
+	}
+	return nil
+}
+
+func (x *SpotifyRelease) GetPopularity() int32 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *SpotifyRelease) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SpotifyRelease) GetAlbumGroup() string {
+	if x != nil {
+		return x.AlbumGroup
+	}
+	return ""
+}
+
+type SpotifyCopyright struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "C" for the copyright, "P" for the sound recording (performance) copyright
+}
+
+func (x *SpotifyCopyright) Reset() {
+	*x = SpotifyCopyright{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_spotify_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpotifyCopyright) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpotifyCopyright) ProtoMessage() {}
+
+func (x *SpotifyCopyright) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spotify_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpotifyCopyright.ProtoReflect.Descriptor instead.
+func (*SpotifyCopyright) Descriptor() ([]byte, []int) {
+	return file_proto_spotify_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SpotifyCopyright) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SpotifyCopyright) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type SpotifyTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Isrc              string   `protobuf:"bytes,3,opt,name=isrc,proto3" json:"isrc,omitempty"` // Empty if unknown
+	DurationMs        int32    `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Explicit          bool     `protobuf:"varint,5,opt,name=explicit,proto3" json:"explicit,omitempty"`
+	DiscNumber        int32    `protobuf:"varint,6,opt,name=disc_number,json=discNumber,proto3" json:"disc_number,omitempty"`
+	TrackNumber       int32    `protobuf:"varint,7,opt,name=track_number,json=trackNumber,proto3" json:"track_number,omitempty"`
+	ArtistIds         []string `protobuf:"bytes,8,rep,name=artist_ids,json=artistIds,proto3" json:"artist_ids,omitempty"`
+	FeaturedArtistIds []string `protobuf:"bytes,9,rep,name=featured_artist_ids,json=featuredArtistIds,proto3" json:"featured_artist_ids,omitempty"` // Artists of the track that aren't credited on the release
+}
+
+func (x *SpotifyTrack) Reset() {
+	*x = SpotifyTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_spotify_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpotifyTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpotifyTrack) ProtoMessage() {}
+
+func (x *SpotifyTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spotify_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpotifyTrack.ProtoReflect.Descriptor instead.
+func (*SpotifyTrack) Descriptor() ([]byte, []int) {
+	return file_proto_spotify_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SpotifyTrack) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SpotifyTrack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpotifyTrack) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *SpotifyTrack) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *SpotifyTrack) GetExplicit() bool {
+	if x != nil {
+		return x.Explicit
+	}
+	return false
+}
+
+func (x *SpotifyTrack) GetDiscNumber() int32 {
+	if x != nil {
+		return x.DiscNumber
+	}
+	return 0
+}
+
+func (x *SpotifyTrack) GetTrackNumber() int32 {
+	if x != nil {
+		return x.TrackNumber
+	}
+	return 0
+}
+
+func (x *SpotifyTrack) GetArtistIds() []string {
+	if x != nil {
+		return x.ArtistIds
+	}
+	return nil
+}
+
+func (x *SpotifyTrack) GetFeaturedArtistIds() []string {
+	if x != nil {
+		return x.FeaturedArtistIds
+	}
+	return nil
+}
+
 type SpotifyImage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -191,7 +500,7 @@ type SpotifyImage struct {
 func (x *SpotifyImage) Reset() {
 	*x = SpotifyImage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_spotify_proto_msgTypes[2]
+		mi := &file_proto_spotify_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -204,7 +513,7 @@ func (x *SpotifyImage) String() string {
 func (*SpotifyImage) ProtoMessage() {}
 
 func (x *SpotifyImage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_spotify_proto_msgTypes[2]
+	mi := &file_proto_spotify_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,7 +526,7 @@ func (x *SpotifyImage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpotifyImage.ProtoReflect.Descriptor instead.
 func (*SpotifyImage) Descriptor() ([]byte, []int) {
-	return file_proto_spotify_proto_rawDescGZIP(), []int{2}
+	return file_proto_spotify_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SpotifyImage) GetUrl() string {
@@ -252,7 +561,7 @@ type GetArtistRequest struct {
 func (x *GetArtistRequest) Reset() {
 	*x = GetArtistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_spotify_proto_msgTypes[3]
+		mi := &file_proto_spotify_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -265,7 +574,7 @@ func (x *GetArtistRequest) String() string {
 func (*GetArtistRequest) ProtoMessage() {}
 
 func (x *GetArtistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_spotify_proto_msgTypes[3]
+	mi := &file_proto_spotify_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +587,7 @@ func (x *GetArtistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArtistRequest.ProtoReflect.Descriptor instead.
 func (*GetArtistRequest) Descriptor() ([]byte, []int) {
-	return file_proto_spotify_proto_rawDescGZIP(), []int{3}
+	return file_proto_spotify_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetArtistRequest) GetId() string {
@@ -299,7 +608,7 @@ type GetArtistsForUserRequest struct {
 func (x *GetArtistsForUserRequest) Reset() {
 	*x = GetArtistsForUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_spotify_proto_msgTypes[4]
+		mi := &file_proto_spotify_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -312,7 +621,7 @@ func (x *GetArtistsForUserRequest) String() string {
 func (*GetArtistsForUserRequest) ProtoMessage() {}
 
 func (x *GetArtistsForUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_spotify_proto_msgTypes[4]
+	mi := &file_proto_spotify_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +634,7 @@ func (x *GetArtistsForUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArtistsForUserRequest.ProtoReflect.Descriptor instead.
 func (*GetArtistsForUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_spotify_proto_rawDescGZIP(), []int{4}
+	return file_proto_spotify_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetArtistsForUserRequest) GetUserId() string {
@@ -346,7 +655,7 @@ type GetArtistsForUserResponse struct {
 func (x *GetArtistsForUserResponse) Reset() {
 	*x = GetArtistsForUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_spotify_proto_msgTypes[5]
+		mi := &file_proto_spotify_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -359,7 +668,7 @@ func (x *GetArtistsForUserResponse) String() string {
 func (*GetArtistsForUserResponse) ProtoMessage() {}
 
 func (x *GetArtistsForUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_spotify_proto_msgTypes[5]
+	mi := &file_proto_spotify_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +681,7 @@ func (x *GetArtistsForUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArtistsForUserResponse.ProtoReflect.Descriptor instead.
 func (*GetArtistsForUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_spotify_proto_rawDescGZIP(), []int{5}
+	return file_proto_spotify_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetArtistsForUserResponse) GetArtistIds() []string {
@@ -387,13 +696,14 @@ type GetReleasesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArtistId string `protobuf:"bytes,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	ArtistId    string   `protobuf:"bytes,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	AlbumGroups []string `protobuf:"bytes,2,rep,name=album_groups,json=albumGroups,proto3" json:"album_groups,omitempty"` // Any of "album", "single", "compilation" and "appears_on", the configured groups if empty
 }
 
 func (x *GetReleasesRequest) Reset() {
 	*x = GetReleasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_spotify_proto_msgTypes[6]
+		mi := &file_proto_spotify_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -406,7 +716,7 @@ func (x *GetReleasesRequest) String() string {
 func (*GetReleasesRequest) ProtoMessage() {}
 
 func (x *GetReleasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_spotify_proto_msgTypes[6]
+	mi := &file_proto_spotify_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +729,7 @@ func (x *GetReleasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReleasesRequest.ProtoReflect.Descriptor instead.
 func (*GetReleasesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_spotify_proto_rawDescGZIP(), []int{6}
+	return file_proto_spotify_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetReleasesRequest) GetArtistId() string {
@@ -429,6 +739,13 @@ func (x *GetReleasesRequest) GetArtistId() string {
 	return ""
 }
 
+func (x *GetReleasesRequest) GetAlbumGroups() []string {
+	if x != nil {
+		return x.AlbumGroups
+	}
+	return nil
+}
+
 type GetReleasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -440,7 +757,7 @@ type GetReleasesResponse struct {
 func (x *GetReleasesResponse) Reset() {
 	*x = GetReleasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_spotify_proto_msgTypes[7]
+		mi := &file_proto_spotify_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +770,7 @@ func (x *GetReleasesResponse) String() string {
 func (*GetReleasesResponse) ProtoMessage() {}
 
 func (x *GetReleasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_spotify_proto_msgTypes[7]
+	mi := &file_proto_spotify_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +783,7 @@ func (x *GetReleasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReleasesResponse.ProtoReflect.Descriptor instead.
 func (*GetReleasesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_spotify_proto_rawDescGZIP(), []int{7}
+	return file_proto_spotify_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetReleasesResponse) GetReleases() []*SpotifyRelease {
@@ -476,71 +793,237 @@ func (x *GetReleasesResponse) GetReleases() []*SpotifyRelease {
 	return nil
 }
 
+type GetTracksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseId string `protobuf:"bytes,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"` // This is a spotify album id
+}
+
+func (x *GetTracksRequest) Reset() {
+	*x = GetTracksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_spotify_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTracksRequest) ProtoMessage() {}
+
+func (x *GetTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spotify_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTracksRequest.ProtoReflect.Descriptor instead.
+func (*GetTracksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_spotify_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTracksRequest) GetReleaseId() string {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return ""
+}
+
+type GetTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tracks []*SpotifyTrack `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *GetTracksResponse) Reset() {
+	*x = GetTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_spotify_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTracksResponse) ProtoMessage() {}
+
+func (x *GetTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spotify_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTracksResponse.ProtoReflect.Descriptor instead.
+func (*GetTracksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_spotify_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTracksResponse) GetTracks() []*SpotifyTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
 var File_proto_spotify_proto protoreflect.FileDescriptor
 
 var file_proto_spotify_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x22, 0x7a,
-	0x0a, 0x0d, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x53, 0x70,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x0e, 0x53,
-	0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x0c, 0x53, 0x70,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22,
-	0x31, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x49, 0x64, 0x22, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x32, 0x85,
-	0x02, 0x0a, 0x0e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x19,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x22, 0xb8,
+	0x01, 0x0a, 0x0d, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x53,
+	0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x22, 0xa5, 0x04, 0x0a, 0x0e, 0x53, 0x70,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x16, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x70, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x70, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x70, 0x63, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x53, 0x70,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0a,
+	0x63, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f,
+	0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x22, 0x3a, 0x0a, 0x10, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x70, 0x79,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x96, 0x02,
+	0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x72, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x0c, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x3a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x46, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x22, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x31, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x22, 0x42, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e,
+	0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x2a, 0x9d, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
+	0x1e, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52,
+	0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54,
+	0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x41, 0x52,
+	0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41,
+	0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x4e,
+	0x54, 0x48, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x41, 0x59, 0x10, 0x03, 0x32, 0xd5, 0x02, 0x0a, 0x0e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21,
 	0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x70, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
-	0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
-	0x46, 0x6f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x08, 0x5a, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12,
+	0x1b, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
+	0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x08, 0x5a, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -555,32 +1038,43 @@ func file_proto_spotify_proto_rawDescGZIP() []byte {
 	return file_proto_spotify_proto_rawDescData
 }
 
-var file_proto_spotify_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_spotify_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_spotify_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_proto_spotify_proto_goTypes = []interface{}{
-	(*SpotifyArtist)(nil),             // 0: spotify.SpotifyArtist
-	(*SpotifyRelease)(nil),            // 1: spotify.SpotifyRelease
-	(*SpotifyImage)(nil),              // 2: spotify.SpotifyImage
-	(*GetArtistRequest)(nil),          // 3: spotify.GetArtistRequest
-	(*GetArtistsForUserRequest)(nil),  // 4: spotify.GetArtistsForUserRequest
-	(*GetArtistsForUserResponse)(nil), // 5: spotify.GetArtistsForUserResponse
-	(*GetReleasesRequest)(nil),        // 6: spotify.GetReleasesRequest
-	(*GetReleasesResponse)(nil),       // 7: spotify.GetReleasesResponse
+	(ReleaseDatePrecision)(0),         // 0: spotify.ReleaseDatePrecision
+	(*SpotifyArtist)(nil),             // 1: spotify.SpotifyArtist
+	(*SpotifyRelease)(nil),            // 2: spotify.SpotifyRelease
+	(*SpotifyCopyright)(nil),          // 3: spotify.SpotifyCopyright
+	(*SpotifyTrack)(nil),              // 4: spotify.SpotifyTrack
+	(*SpotifyImage)(nil),              // 5: spotify.SpotifyImage
+	(*GetArtistRequest)(nil),          // 6: spotify.GetArtistRequest
+	(*GetArtistsForUserRequest)(nil),  // 7: spotify.GetArtistsForUserRequest
+	(*GetArtistsForUserResponse)(nil), // 8: spotify.GetArtistsForUserResponse
+	(*GetReleasesRequest)(nil),        // 9: spotify.GetReleasesRequest
+	(*GetReleasesResponse)(nil),       // 10: spotify.GetReleasesResponse
+	(*GetTracksRequest)(nil),          // 11: spotify.GetTracksRequest
+	(*GetTracksResponse)(nil),         // 12: spotify.GetTracksResponse
 }
 var file_proto_spotify_proto_depIdxs = []int32{
-	2, // 0: spotify.SpotifyArtist.images:type_name -> spotify.SpotifyImage
-	2, // 1: spotify.SpotifyRelease.images:type_name -> spotify.SpotifyImage
-	1, // 2: spotify.GetReleasesResponse.releases:type_name -> spotify.SpotifyRelease
-	3, // 3: spotify.SpotifyService.GetArtist:input_type -> spotify.GetArtistRequest
-	4, // 4: spotify.SpotifyService.GetArtistsForUser:input_type -> spotify.GetArtistsForUserRequest
-	6, // 5: spotify.SpotifyService.GetReleasesForArtist:input_type -> spotify.GetReleasesRequest
-	0, // 6: spotify.SpotifyService.GetArtist:output_type -> spotify.SpotifyArtist
-	5, // 7: spotify.SpotifyService.GetArtistsForUser:output_type -> spotify.GetArtistsForUserResponse
-	7, // 8: spotify.SpotifyService.GetReleasesForArtist:output_type -> spotify.GetReleasesResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5,  // 0: spotify.SpotifyArtist.images:type_name -> spotify.SpotifyImage
+	5,  // 1: spotify.SpotifyRelease.images:type_name -> spotify.SpotifyImage
+	0,  // 2: spotify.SpotifyRelease.release_date_precision:type_name -> spotify.ReleaseDatePrecision
+	3,  // 3: spotify.SpotifyRelease.copyrights:type_name -> spotify.SpotifyCopyright
+	2,  // 4: spotify.GetReleasesResponse.releases:type_name -> spotify.SpotifyRelease
+	4,  // 5: spotify.GetTracksResponse.tracks:type_name -> spotify.SpotifyTrack
+	6,  // 6: spotify.SpotifyService.GetArtist:input_type -> spotify.GetArtistRequest
+	7,  // 7: spotify.SpotifyService.GetArtistsForUser:input_type -> spotify.GetArtistsForUserRequest
+	9,  // 8: spotify.SpotifyService.GetReleasesForArtist:input_type -> spotify.GetReleasesRequest
+	11, // 9: spotify.SpotifyService.GetTracksForRelease:input_type -> spotify.GetTracksRequest
+	1,  // 10: spotify.SpotifyService.GetArtist:output_type -> spotify.SpotifyArtist
+	8,  // 11: spotify.SpotifyService.GetArtistsForUser:output_type -> spotify.GetArtistsForUserResponse
+	10, // 12: spotify.SpotifyService.GetReleasesForArtist:output_type -> spotify.GetReleasesResponse
+	12, // 13: spotify.SpotifyService.GetTracksForRelease:output_type -> spotify.GetTracksResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_proto_spotify_proto_init() }
@@ -614,7 +1108,7 @@ func file_proto_spotify_proto_init() {
 			}
 		}
 		file_proto_spotify_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpotifyImage); i {
+			switch v := v.(*SpotifyCopyright); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -626,7 +1120,7 @@ func file_proto_spotify_proto_init() {
 			}
 		}
 		file_proto_spotify_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArtistRequest); i {
+			switch v := v.(*SpotifyTrack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -638,7 +1132,7 @@ func file_proto_spotify_proto_init() {
 			}
 		}
 		file_proto_spotify_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArtistsForUserRequest); i {
+			switch v := v.(*SpotifyImage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -650,7 +1144,7 @@ func file_proto_spotify_proto_init() {
 			}
 		}
 		file_proto_spotify_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArtistsForUserResponse); i {
+			switch v := v.(*GetArtistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -662,7 +1156,7 @@ func file_proto_spotify_proto_init() {
 			}
 		}
 		file_proto_spotify_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReleasesRequest); i {
+			switch v := v.(*GetArtistsForUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -674,6 +1168,30 @@ func file_proto_spotify_proto_init() {
 			}
 		}
 		file_proto_spotify_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArtistsForUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_spotify_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_spotify_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetReleasesResponse); i {
 			case 0:
 				return &v.state
@@ -685,19 +1203,44 @@ func file_proto_spotify_proto_init() {
 				return nil
 			}
 		}
+		file_proto_spotify_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_spotify_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_spotify_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_spotify_proto_goTypes,
 		DependencyIndexes: file_proto_spotify_proto_depIdxs,
+		EnumInfos:         file_proto_spotify_proto_enumTypes,
 		MessageInfos:      file_proto_spotify_proto_msgTypes,
 	}.Build()
 	File_proto_spotify_proto = out.File
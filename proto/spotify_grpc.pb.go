@@ -25,6 +25,7 @@ type SpotifyServiceClient interface {
 	GetArtist(ctx context.Context, in *GetArtistRequest, opts ...grpc.CallOption) (*SpotifyArtist, error)
 	GetArtistsForUser(ctx context.Context, in *GetArtistsForUserRequest, opts ...grpc.CallOption) (*GetArtistsForUserResponse, error)
 	GetReleasesForArtist(ctx context.Context, in *GetReleasesRequest, opts ...grpc.CallOption) (*GetReleasesResponse, error)
+	GetTracksForRelease(ctx context.Context, in *GetTracksRequest, opts ...grpc.CallOption) (*GetTracksResponse, error)
 }
 
 type spotifyServiceClient struct {
@@ -62,6 +63,15 @@ func (c *spotifyServiceClient) GetReleasesForArtist(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *spotifyServiceClient) GetTracksForRelease(ctx context.Context, in *GetTracksRequest, opts ...grpc.CallOption) (*GetTracksResponse, error) {
+	out := new(GetTracksResponse)
+	err := c.cc.Invoke(ctx, "/spotify.SpotifyService/GetTracksForRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpotifyServiceServer is the server API for SpotifyService service.
 // All implementations must embed UnimplementedSpotifyServiceServer
 // for forward compatibility
@@ -69,6 +79,7 @@ type SpotifyServiceServer interface {
 	GetArtist(context.Context, *GetArtistRequest) (*SpotifyArtist, error)
 	GetArtistsForUser(context.Context, *GetArtistsForUserRequest) (*GetArtistsForUserResponse, error)
 	GetReleasesForArtist(context.Context, *GetReleasesRequest) (*GetReleasesResponse, error)
+	GetTracksForRelease(context.Context, *GetTracksRequest) (*GetTracksResponse, error)
 	mustEmbedUnimplementedSpotifyServiceServer()
 }
 
@@ -85,6 +96,9 @@ func (UnimplementedSpotifyServiceServer) GetArtistsForUser(context.Context, *Get
 func (UnimplementedSpotifyServiceServer) GetReleasesForArtist(context.Context, *GetReleasesRequest) (*GetReleasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReleasesForArtist not implemented")
 }
+func (UnimplementedSpotifyServiceServer) GetTracksForRelease(context.Context, *GetTracksRequest) (*GetTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTracksForRelease not implemented")
+}
 func (UnimplementedSpotifyServiceServer) mustEmbedUnimplementedSpotifyServiceServer() {}
 
 // UnsafeSpotifyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +166,24 @@ func _SpotifyService_GetReleasesForArtist_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpotifyService_GetTracksForRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpotifyServiceServer).GetTracksForRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spotify.SpotifyService/GetTracksForRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpotifyServiceServer).GetTracksForRelease(ctx, req.(*GetTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SpotifyService_ServiceDesc is the grpc.ServiceDesc for SpotifyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +203,10 @@ var SpotifyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReleasesForArtist",
 			Handler:    _SpotifyService_GetReleasesForArtist_Handler,
 		},
+		{
+			MethodName: "GetTracksForRelease",
+			Handler:    _SpotifyService_GetTracksForRelease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/spotify.proto",
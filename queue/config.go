@@ -0,0 +1,117 @@
+package queue
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+// Config describes how to connect to RabbitMQ and how the consumed queues are declared.
+type Config struct {
+	// URL is the amqp:// or amqps:// URL of the broker, including credentials and vhost.
+	URL string
+	// TLS is used for amqps connections, nil connects without TLS.
+	TLS *tls.Config
+	// Durable declares the queues and the exchange as durable.
+	Durable bool
+	// Exchange the queues are bound to, the default exchange is used if it's empty.
+	Exchange string
+	// ExchangeType is the type of Exchange, e.g. "direct" or "topic".
+	ExchangeType string
+}
+
+// Queue describes a queue to consume.
+type Queue struct {
+	Name string
+	// RoutingKey the queue is bound to Config.Exchange with, it defaults to the queue name.
+	RoutingKey string
+}
+
+// LoadConfig reads the RabbitMQ configuration from the environment.
+// RABBITMQ_URL takes precedence over the individual RABBITMQ_HOST, RABBITMQ_USER, RABBITMQ_PASSWORD and RABBITMQ_VHOST
+// settings, which default to the local development broker.
+func LoadConfig() (Config, error) {
+	config := Config{
+		URL:          os.Getenv("RABBITMQ_URL"),
+		Durable:      getEnvBool("RABBITMQ_DURABLE", false),
+		Exchange:     os.Getenv("RABBITMQ_EXCHANGE"),
+		ExchangeType: getEnv("RABBITMQ_EXCHANGE_TYPE", "direct"),
+	}
+
+	useTLS := getEnvBool("RABBITMQ_TLS", false)
+	if config.URL == "" {
+		scheme := "amqp"
+		if useTLS {
+			scheme = "amqps"
+		}
+
+		u := url.URL{
+			Scheme: scheme,
+			User:   url.UserPassword(getEnv("RABBITMQ_USER", "guest"), getEnv("RABBITMQ_PASSWORD", "guest")),
+			Host:   getEnv("RABBITMQ_HOST", "rabbitmq:5672"),
+			Path:   "/",
+		}
+		if vhost := os.Getenv("RABBITMQ_VHOST"); vhost != "" && vhost != "/" {
+			u.Path = "/" + vhost
+		}
+		config.URL = u.String()
+	}
+
+	if useTLS {
+		tlsConfig, err := loadTLSConfig()
+		if err != nil {
+			return config, err
+		}
+		config.TLS = tlsConfig
+	}
+
+	return config, nil
+}
+
+// loadTLSConfig builds the TLS configuration from RABBITMQ_TLS_CA_FILE, RABBITMQ_TLS_CERT_FILE and RABBITMQ_TLS_KEY_FILE.
+// Without a CA file the system roots are used, the client certificate is optional.
+func loadTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile := os.Getenv("RABBITMQ_TLS_CA_FILE"); caFile != "" {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("no certificates found in RABBITMQ_TLS_CA_FILE")
+		}
+	}
+
+	certFile, keyFile := os.Getenv("RABBITMQ_TLS_CERT_FILE"), os.Getenv("RABBITMQ_TLS_KEY_FILE")
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// getEnv reads a variable from the environment, falling back to def if it is unset.
+func getEnv(key string, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return def
+}
+
+// getEnvBool reads a boolean from the environment, falling back to def if it is unset or invalid.
+func getEnvBool(key string, def bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return def
+	}
+	return value
+}
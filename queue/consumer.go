@@ -0,0 +1,205 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+)
+
+// Handler handles the body of a single message.
+type Handler func(ctx context.Context, body []byte) error
+
+// permanentError marks an error that retrying won't fix.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wraps an error so the message is moved to the dead-letter queue right away instead of being retried.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// Consumer consumes a queue with manual acknowledgements.
+// Failed messages are retried through the retry queue and dead-lettered after MaxAttempts attempts.
+type Consumer struct {
+	ch      *amqp.Channel
+	queue   string
+	handler Handler
+	workers int
+	msgs    <-chan amqp.Delivery
+	stopped atomic.Bool
+}
+
+// NewConsumer creates a consumer for a queue declared with Declare, handling up to workers messages in parallel.
+func NewConsumer(ch *amqp.Channel, queue string, handler Handler, workers int) *Consumer {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Consumer{ch: ch, queue: queue, handler: handler, workers: workers}
+}
+
+// Start registers the consumer with the broker.
+func (c *Consumer) Start() error {
+	// Only prefetch as many messages as there are workers, the rest wait in the queue until a worker is free
+	err := c.ch.Qos(c.workers, 0, false)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := c.ch.Consume(
+		c.queue, // queue
+		c.queue, // consumer
+		false,   // auto-ack
+		false,   // exclusive
+		false,   // no-local
+		false,   // no-wait
+		nil,     // args
+	)
+	if err != nil {
+		return err
+	}
+
+	c.msgs = msgs
+	return nil
+}
+
+// Run handles messages with the consumer's workers until the consumer is stopped or the channel is closed.
+// ctx is passed on to the handler, cancelling it aborts the messages currently being handled.
+func (c *Consumer) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < c.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// work handles messages one after another until the delivery channel is closed.
+func (c *Consumer) work(ctx context.Context) {
+	for d := range c.msgs {
+		// Messages that were already delivered when the consumer was stopped go back to the queue
+		if c.stopped.Load() {
+			if err := d.Nack(false, true); err != nil {
+				zap.L().Error("Failed to nack message", zap.String("queue", c.queue), zap.Error(err))
+			}
+			continue
+		}
+
+		c.handle(ctx, d)
+	}
+}
+
+// Stop cancels the consumer, so the broker stops delivering new messages.
+// The message currently being handled is still finished by Run.
+func (c *Consumer) Stop() error {
+	c.stopped.Store(true)
+	return c.ch.Cancel(c.queue, false)
+}
+
+// handle runs the handler for a delivery and acknowledges, retries or dead-letters it.
+func (c *Consumer) handle(ctx context.Context, d amqp.Delivery) {
+	err := c.handler(ctx, d.Body)
+	if err == nil {
+		if err := d.Ack(false); err != nil {
+			zap.L().Error("Failed to ack message", zap.String("queue", c.queue), zap.Error(err))
+		}
+		return
+	}
+
+	// The handler was aborted because we are shutting down, so the message goes back to the queue as is
+	if ctx.Err() != nil {
+		zap.L().Warn("Message handling aborted", zap.String("queue", c.queue), zap.Error(err))
+		if err := d.Nack(false, true); err != nil {
+			zap.L().Error("Failed to nack message", zap.String("queue", c.queue), zap.Error(err))
+		}
+		return
+	}
+
+	target, attempt := retryTarget(c.queue, d.Headers, err)
+	zap.L().Warn("Failed to handle message",
+		zap.String("queue", c.queue),
+		zap.Int("attempt", attempt),
+		zap.String("target", target),
+		zap.Error(err),
+	)
+
+	headers := amqp.Table{}
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	headers[retryCountHeader] = int32(attempt)
+	headers[lastErrorHeader] = err.Error()
+
+	err = c.ch.PublishWithContext(ctx,
+		"",     // exchange
+		target, // routing key
+		false,  // mandatory
+		false,  // immediate
+		amqp.Publishing{
+			Headers:      headers,
+			ContentType:  d.ContentType,
+			DeliveryMode: d.DeliveryMode,
+			MessageId:    d.MessageId,
+			Timestamp:    d.Timestamp,
+			Type:         d.Type,
+			Body:         d.Body,
+		})
+	if err != nil {
+		// We couldn't move the message, so hand it back to the broker to be redelivered
+		zap.L().Error("Failed to republish message", zap.String("queue", c.queue), zap.String("target", target), zap.Error(err))
+		if err := d.Nack(false, true); err != nil {
+			zap.L().Error("Failed to nack message", zap.String("queue", c.queue), zap.Error(err))
+		}
+		return
+	}
+
+	if err := d.Ack(false); err != nil {
+		zap.L().Error("Failed to ack message", zap.String("queue", c.queue), zap.Error(err))
+	}
+}
+
+// retryTarget returns the queue a message of queue that failed with err is moved to, and the attempt that failed.
+// Messages are retried until they failed MaxAttempts times, permanent errors are dead-lettered right away.
+func retryTarget(queue string, headers amqp.Table, err error) (target string, attempt int) {
+	attempt = retryCount(headers) + 1
+	if attempt >= MaxAttempts || errors.As(err, &permanentError{}) {
+		return DeadLetterQueue(queue), attempt
+	}
+	return RetryQueue(queue), attempt
+}
+
+// retryCount reads how often a message has been retried from its headers.
+// The header may have been set by other publishers, so any integer type the AMQP table decodes to is accepted.
+func retryCount(headers amqp.Table) int {
+	var count int
+	switch v := headers[retryCountHeader].(type) {
+	case int8:
+		count = int(v)
+	case uint8:
+		count = int(v)
+	case int16:
+		count = int(v)
+	case int32:
+		count = int(v)
+	case int64:
+		count = int(v)
+	case int:
+		count = v
+	}
+	return max(count, 0)
+}
@@ -0,0 +1,109 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func TestRetryCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers amqp.Table
+		want    int
+	}{
+		{"missing", amqp.Table{}, 0},
+		{"nil headers", nil, 0},
+		{"int8", amqp.Table{retryCountHeader: int8(1)}, 1},
+		{"uint8", amqp.Table{retryCountHeader: uint8(2)}, 2},
+		{"int16", amqp.Table{retryCountHeader: int16(3)}, 3},
+		{"int32", amqp.Table{retryCountHeader: int32(4)}, 4},
+		{"int64", amqp.Table{retryCountHeader: int64(5)}, 5},
+		{"int", amqp.Table{retryCountHeader: 6}, 6},
+		{"negative", amqp.Table{retryCountHeader: int32(-3)}, 0},
+		{"string", amqp.Table{retryCountHeader: "2"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryCount(tt.headers); got != tt.want {
+				t.Errorf("retryCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryTarget(t *testing.T) {
+	failed := errors.New("failed")
+	tests := []struct {
+		name        string
+		headers     amqp.Table
+		err         error
+		wantTarget  string
+		wantAttempt int
+	}{
+		{"first failure", nil, failed, "spotify-user.retry", 1},
+		{"retried failure", amqp.Table{retryCountHeader: int32(2)}, failed, "spotify-user.retry", 3},
+		{"last attempt", amqp.Table{retryCountHeader: int32(MaxAttempts - 1)}, failed, "spotify-user.dlq", MaxAttempts},
+		{"beyond last attempt", amqp.Table{retryCountHeader: int32(MaxAttempts + 3)}, failed, "spotify-user.dlq", MaxAttempts + 4},
+		{"permanent", nil, Permanent(failed), "spotify-user.dlq", 1},
+		{"wrapped permanent", nil, errors.Join(errors.New("context"), Permanent(failed)), "spotify-user.dlq", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, attempt := retryTarget("spotify-user", tt.headers, tt.err)
+			if target != tt.wantTarget || attempt != tt.wantAttempt {
+				t.Errorf("retryTarget() = %q, %d, want %q, %d", target, attempt, tt.wantTarget, tt.wantAttempt)
+			}
+		})
+	}
+}
+
+// fakeAcknowledger records how a delivery was acknowledged.
+type fakeAcknowledger struct {
+	acked   bool
+	nacked  bool
+	requeue bool
+}
+
+func (a *fakeAcknowledger) Ack(tag uint64, multiple bool) error {
+	a.acked = true
+	return nil
+}
+
+func (a *fakeAcknowledger) Nack(tag uint64, multiple bool, requeue bool) error {
+	a.nacked = true
+	a.requeue = requeue
+	return nil
+}
+
+func (a *fakeAcknowledger) Reject(tag uint64, requeue bool) error {
+	return a.Nack(tag, false, requeue)
+}
+
+func TestHandleAcksSuccess(t *testing.T) {
+	ack := &fakeAcknowledger{}
+	c := NewConsumer(nil, "spotify-user", func(ctx context.Context, body []byte) error { return nil }, 1)
+
+	c.handle(context.Background(), amqp.Delivery{Acknowledger: ack})
+
+	if !ack.acked || ack.nacked {
+		t.Errorf("acked = %t, nacked = %t, want only acked", ack.acked, ack.nacked)
+	}
+}
+
+func TestHandleRequeuesOnShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ack := &fakeAcknowledger{}
+	c := NewConsumer(nil, "spotify-user", func(ctx context.Context, body []byte) error {
+		cancel()
+		return ctx.Err()
+	}, 1)
+
+	c.handle(ctx, amqp.Delivery{Acknowledger: ack})
+
+	if !ack.nacked || !ack.requeue || ack.acked {
+		t.Errorf("acked = %t, nacked = %t, requeue = %t, want only nacked with requeue", ack.acked, ack.nacked, ack.requeue)
+	}
+}
@@ -0,0 +1,222 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+)
+
+const (
+	// minReconnectDelay is the delay before the first reconnection attempt, it doubles with every failed attempt.
+	minReconnectDelay = time.Second
+	// maxReconnectDelay caps the delay between reconnection attempts.
+	maxReconnectDelay = 30 * time.Second
+)
+
+// ErrNotConnected is returned when publishing while there is no connection to the broker.
+var ErrNotConnected = errors.New("not connected to RabbitMQ")
+
+// Manager owns the RabbitMQ connection. Whenever the connection or channel closes, it reconnects with backoff,
+// redeclares the queues and restarts the consumers.
+type Manager struct {
+	config    Config
+	handlers  map[string]Handler
+	workers   map[string]int
+	queues    []Queue
+	exchanges []string
+
+	// ch is the channel of the current session, nil while disconnected
+	mu sync.RWMutex
+	ch *amqp.Channel
+}
+
+// NewManager creates a manager for the broker described by config.
+func NewManager(config Config) *Manager {
+	return &Manager{config: config, handlers: map[string]Handler{}, workers: map[string]int{}}
+}
+
+// Consume registers a handler for a queue, which handles up to workers messages in parallel.
+// It has to be called before Run.
+func (m *Manager) Consume(queue Queue, handler Handler, workers int) {
+	m.queues = append(m.queues, queue)
+	m.handlers[queue.Name] = handler
+	m.workers[queue.Name] = workers
+}
+
+// DeclareTopicExchange registers a topic exchange that is declared on every connect, so events can be published to it.
+// It has to be called before Run.
+func (m *Manager) DeclareTopicExchange(name string) {
+	m.exchanges = append(m.exchanges, name)
+}
+
+// Publish publishes a persistent JSON message to an exchange. It fails if there is currently no connection.
+func (m *Manager) Publish(ctx context.Context, exchange string, routingKey string, body []byte) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.ch == nil {
+		return ErrNotConnected
+	}
+
+	return m.ch.PublishWithContext(ctx,
+		exchange,   // exchange
+		routingKey, // routing key
+		false,      // mandatory
+		false,      // immediate
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			Timestamp:    time.Now(),
+			Body:         body,
+		})
+}
+
+// Run connects to the broker and consumes the registered queues until ctx is cancelled.
+// Handlers are called with a context derived from workCtx. After ctx is cancelled, Run stops consuming, waits for
+// the messages currently being handled and closes the connection. Cancelling workCtx aborts these messages.
+// If the connection is lost instead, the handlers are cancelled and Run reconnects without waiting for them,
+// as their messages are redelivered anyway.
+func (m *Manager) Run(ctx context.Context, workCtx context.Context) {
+	delay := minReconnectDelay
+	for {
+		err := m.session(ctx, workCtx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			zap.L().Error("Failed to connect to RabbitMQ", zap.Duration("retry_in", delay), zap.Error(err))
+		} else {
+			// The last session was established, so start over with a short delay
+			delay = minReconnectDelay
+			zap.L().Info("Reconnecting to RabbitMQ", zap.Duration("retry_in", delay))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// session runs a single connection until it closes. It only returns an error if the session couldn't be established.
+func (m *Manager) session(ctx context.Context, workCtx context.Context) error {
+	zap.S().Info("Connecting to RabbitMQ")
+	conn, err := m.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	for _, queue := range m.queues {
+		err = Declare(ch, m.config, queue)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, exchange := range m.exchanges {
+		err = ch.ExchangeDeclare(
+			exchange, // name
+			"topic",  // type
+			true,     // durable
+			false,    // auto-deleted
+			false,    // internal
+			false,    // no-wait
+			nil,      // arguments
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	connClosed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	chClosed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+	// Publish on this channel until the session ends
+	m.setChannel(ch)
+	defer m.setChannel(nil)
+
+	// The handlers can only ack on this channel, so they are cancelled once the session is lost
+	sessionCtx, cancelSession := context.WithCancel(workCtx)
+	defer cancelSession()
+
+	// Start the consumers, they stop once they are cancelled or the channel closes
+	var consumers []*Consumer
+	var names []string
+	var wg sync.WaitGroup
+	for _, queue := range m.queues {
+		consumer := NewConsumer(ch, queue.Name, m.handlers[queue.Name], m.workers[queue.Name])
+		err = consumer.Start()
+		if err != nil {
+			return err
+		}
+		consumers = append(consumers, consumer)
+		names = append(names, queue.Name)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			consumer.Run(sessionCtx)
+		}()
+	}
+	zap.L().Info("Connected to RabbitMQ", zap.Strings("queues", names))
+
+	select {
+	case <-ctx.Done():
+		zap.S().Info("Stopping RabbitMQ consumers")
+		for _, consumer := range consumers {
+			if err := consumer.Stop(); err != nil {
+				zap.L().Error("Failed to stop consumer", zap.Error(err))
+			}
+		}
+
+		// Wait for the messages currently being handled before the channel is closed
+		wg.Wait()
+		zap.S().Info("Closing RabbitMQ connection")
+		return nil
+	case err := <-connClosed:
+		zap.L().Warn("RabbitMQ connection closed", zap.Error(err))
+	case err := <-chClosed:
+		zap.L().Warn("RabbitMQ channel closed", zap.Error(err))
+	}
+
+	// The messages currently being handled can't be acked anymore and will be redelivered. So stop publishing on the
+	// dead channel and cancel their handlers, but reconnect right away instead of waiting for them to return.
+	m.setChannel(nil)
+	cancelSession()
+	zap.L().Warn("Abandoning in-flight messages, they will be redelivered", zap.Strings("queues", names))
+
+	return nil
+}
+
+// setChannel sets the channel used for publishing.
+func (m *Manager) setChannel(ch *amqp.Channel) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ch = ch
+}
+
+// dial connects to the broker, using TLS if it is configured.
+func (m *Manager) dial() (*amqp.Connection, error) {
+	if m.config.TLS != nil {
+		return amqp.DialTLS(m.config.URL, m.config.TLS)
+	}
+	return amqp.Dial(m.config.URL)
+}
@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	// MaxAttempts is how often a message is handled before it is moved to the dead-letter queue.
+	MaxAttempts = 5
+	// RetryDelay is how long a failed message waits in the retry queue before it is handled again.
+	RetryDelay = 30 * time.Second
+
+	retryCountHeader = "x-retry-count"
+	lastErrorHeader  = "x-last-error"
+)
+
+// RetryQueue returns the name of the queue failed messages wait in before they are retried.
+func RetryQueue(name string) string {
+	return name + ".retry"
+}
+
+// DeadLetterQueue returns the name of the queue messages end up in after MaxAttempts failed attempts.
+func DeadLetterQueue(name string) string {
+	return name + ".dlq"
+}
+
+// Declare declares a queue together with its retry and dead-letter queues, and binds it to the configured exchange.
+// Messages published to the retry queue expire after RetryDelay and are then routed back to the queue.
+func Declare(ch *amqp.Channel, config Config, queue Queue) error {
+	name := queue.Name
+	_, err := ch.QueueDeclare(
+		name,           // name
+		config.Durable, // durable
+		false,          // delete when unused
+		false,          // exclusive
+		false,          // no-wait
+		nil,            // arguments
+	)
+	if err != nil {
+		return err
+	}
+
+	if config.Exchange != "" {
+		err = ch.ExchangeDeclare(
+			config.Exchange,     // name
+			config.ExchangeType, // type
+			config.Durable,      // durable
+			false,               // auto-deleted
+			false,               // internal
+			false,               // no-wait
+			nil,                 // arguments
+		)
+		if err != nil {
+			return err
+		}
+
+		routingKey := queue.RoutingKey
+		if routingKey == "" {
+			routingKey = name
+		}
+		err = ch.QueueBind(
+			name,            // queue name
+			routingKey,      // routing key
+			config.Exchange, // exchange
+			false,           // no-wait
+			nil,             // arguments
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = ch.QueueDeclare(
+		RetryQueue(name), // name
+		config.Durable,   // durable
+		false,            // delete when unused
+		false,            // exclusive
+		false,            // no-wait
+		amqp.Table{
+			"x-message-ttl":             RetryDelay.Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": name,
+		}, // arguments
+	)
+	if err != nil {
+		return err
+	}
+
+	// The dead-letter queue is always durable, so failed messages can still be inspected after a broker restart
+	_, err = ch.QueueDeclare(
+		DeadLetterQueue(name), // name
+		true,                  // durable
+		false,                 // delete when unused
+		false,                 // exclusive
+		false,                 // no-wait
+		nil,                   // arguments
+	)
+	return err
+}
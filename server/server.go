@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Fan-Fuse/spotify-service/proto"
+	"github.com/Fan-Fuse/spotify-service/service"
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements the SpotifyService gRPC API.
+type Server struct {
+	proto.UnimplementedSpotifyServiceServer
+}
+
+// New creates a new Server.
+func New() *Server {
+	return &Server{}
+}
+
+// GetArtist gets an artist from Spotify.
+func (s *Server) GetArtist(ctx context.Context, req *proto.GetArtistRequest) (*proto.SpotifyArtist, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	artist, err := service.GetArtist(ctx, req.Id)
+	if err != nil {
+		zap.L().Error("Failed to get artist", zap.String("id", req.Id), zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return artist, nil
+}
+
+// GetArtistsForUser gets the spotify IDs of the artists a fanfuse user follows.
+func (s *Server) GetArtistsForUser(ctx context.Context, req *proto.GetArtistsForUserRequest) (*proto.GetArtistsForUserResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	artistIDs, err := service.GetArtistsForUser(ctx, req.UserId)
+	if err != nil {
+		zap.L().Error("Failed to get artists for user", zap.String("user_id", req.UserId), zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &proto.GetArtistsForUserResponse{ArtistIds: artistIDs}, nil
+}
+
+// GetReleasesForArtist gets all releases of an artist from Spotify.
+func (s *Server) GetReleasesForArtist(ctx context.Context, req *proto.GetReleasesRequest) (*proto.GetReleasesResponse, error) {
+	if req.ArtistId == "" {
+		return nil, status.Error(codes.InvalidArgument, "artist_id is required")
+	}
+
+	releases, err := service.GetReleasesForArtist(ctx, req.ArtistId, req.AlbumGroups)
+	if errors.Is(err, service.ErrInvalidAlbumGroup) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		zap.L().Error("Failed to get releases for artist", zap.String("artist_id", req.ArtistId), zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &proto.GetReleasesResponse{Releases: releases}, nil
+}
+
+// GetTracksForRelease gets all tracks of a release from Spotify.
+func (s *Server) GetTracksForRelease(ctx context.Context, req *proto.GetTracksRequest) (*proto.GetTracksResponse, error) {
+	if req.ReleaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "release_id is required")
+	}
+
+	tracks, err := service.GetTracksForRelease(ctx, req.ReleaseId)
+	if err != nil {
+		zap.L().Error("Failed to get tracks for release", zap.String("release_id", req.ReleaseId), zap.Error(err))
+		return nil, toStatus(err)
+	}
+
+	return &proto.GetTracksResponse{Tracks: tracks}, nil
+}
+
+// spotifyCodes maps the HTTP statuses of Spotify errors to gRPC codes.
+var spotifyCodes = map[int]codes.Code{
+	http.StatusBadRequest:      codes.InvalidArgument,
+	http.StatusUnauthorized:    codes.Unauthenticated,
+	http.StatusForbidden:       codes.PermissionDenied,
+	http.StatusNotFound:        codes.NotFound,
+	http.StatusTooManyRequests: codes.ResourceExhausted,
+}
+
+// toStatus converts an error to a gRPC status error, so callers can react to the cause.
+// Status errors, e.g. from the user-service, are passed through as they are.
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	var spotifyErr spotify.Error
+	if errors.As(err, &spotifyErr) {
+		code := codes.Unknown
+		if spotifyErr.Status >= http.StatusInternalServerError {
+			code = codes.Unavailable
+		}
+		if mapped, ok := spotifyCodes[spotifyErr.Status]; ok {
+			code = mapped
+		}
+		return status.Error(code, spotifyErr.Message)
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
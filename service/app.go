@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zmb3/spotify/v2"
+	spotifyauth "github.com/zmb3/spotify/v2/auth"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// appTokenEarlyExpiry is how long before its expiry the app token is refreshed.
+const appTokenEarlyExpiry = time.Minute
+
+var (
+	appHTTPClient *http.Client
+	appClient     *spotify.Client
+	appClientOnce sync.Once
+)
+
+// appTokenSource fetches a new token with the app's client credentials on every call.
+// It is wrapped in a caching token source, so it is only called when the cached token is about to expire.
+type appTokenSource struct {
+	config *clientcredentials.Config
+}
+
+// Token fetches a new client credentials token.
+func (s appTokenSource) Token() (*oauth2.Token, error) {
+	zap.S().Info("Fetching spotify app token")
+	return s.config.Token(context.Background())
+}
+
+// getAppClient returns the shared spotify client authenticated with the app's client credentials.
+// The token is cached and refreshed shortly before it expires, and the client is safe for concurrent use.
+func getAppClient() *spotify.Client {
+	appClientOnce.Do(func() {
+		config := &clientcredentials.Config{
+			ClientID:     os.Getenv("SPOTIFY_ID"),
+			ClientSecret: os.Getenv("SPOTIFY_SECRET"),
+			TokenURL:     spotifyauth.TokenURL,
+		}
+		source := oauth2.ReuseTokenSourceWithExpiry(nil, appTokenSource{config: config}, appTokenEarlyExpiry)
+
+		appHTTPClient = newHTTPClient(source)
+		appClient = spotify.New(appHTTPClient)
+	})
+	return appClient
+}
+
+// getAppHTTPClient returns the http client behind the shared app client, for requests spotify.Client doesn't cover.
+func getAppHTTPClient() *http.Client {
+	getAppClient()
+	return appHTTPClient
+}
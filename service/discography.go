@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Fan-Fuse/spotify-service/clients"
+	"github.com/Fan-Fuse/spotify-service/events"
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidAlbumGroup is returned for album groups Spotify doesn't know.
+var ErrInvalidAlbumGroup = errors.New("invalid album group")
+
+// albumGroups maps the album groups of Spotify to their album type.
+var albumGroups = map[string]spotify.AlbumType{
+	"album":       spotify.AlbumTypeAlbum,
+	"single":      spotify.AlbumTypeSingle,
+	"compilation": spotify.AlbumTypeCompilation,
+	"appears_on":  spotify.AlbumTypeAppearsOn,
+}
+
+// defaultAlbumGroups are the album groups that are synced unless configured otherwise.
+// appears_on is opt-in, as it adds every release the artist is featured on.
+var defaultAlbumGroups = []spotify.AlbumType{spotify.AlbumTypeAlbum, spotify.AlbumTypeSingle, spotify.AlbumTypeCompilation}
+
+// parseAlbumGroups converts album group names like "appears_on" to their album types.
+func parseAlbumGroups(groups []string) ([]spotify.AlbumType, error) {
+	var result []spotify.AlbumType
+	for _, group := range groups {
+		albumType, ok := albumGroups[strings.TrimSpace(group)]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrInvalidAlbumGroup, group)
+		}
+		result = append(result, albumType)
+	}
+	return result, nil
+}
+
+// configuredAlbumGroups returns the album groups set with the SPOTIFY_ALBUM_GROUPS config key,
+// or the default album groups if it is unset or invalid.
+func configuredAlbumGroups() []spotify.AlbumType {
+	value := clients.GetKey("SPOTIFY_ALBUM_GROUPS")
+	if value == "" {
+		return defaultAlbumGroups
+	}
+
+	groups, err := parseAlbumGroups(strings.Split(value, ","))
+	if err != nil {
+		zap.L().Error("Invalid album groups configured, using the default", zap.String("value", value), zap.Error(err))
+		return defaultAlbumGroups
+	}
+	return groups
+}
+
+// albumGroupNames converts album types to their sorted album group names, the inverse of parseAlbumGroups.
+func albumGroupNames(albumTypes []spotify.AlbumType) []string {
+	var names []string
+	for name, albumType := range albumGroups {
+		if slices.Contains(albumTypes, albumType) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// releaseDateLayouts maps the release date precisions of Spotify to the layout of the release date.
+var releaseDateLayouts = map[string]string{
+	"year":  "2006",
+	"month": "2006-01",
+	"day":   spotify.DateLayout,
+}
+
+// releaseDate parses the release date of an album with the precision Spotify knows it with.
+// ok is false if the date is unknown or invalid, instead of guessing a date like spotify.ReleaseDateTime does.
+func releaseDate(album spotify.SimpleAlbum) (date time.Time, precision string, ok bool) {
+	layout, ok := releaseDateLayouts[album.ReleaseDatePrecision]
+	if !ok {
+		return time.Time{}, "", false
+	}
+
+	// Spotify uses dates like "0000" for releases it has no date for
+	date, err := time.Parse(layout, album.ReleaseDate)
+	if err != nil || date.Year() < 1 {
+		return time.Time{}, "", false
+	}
+
+	return date, album.ReleaseDatePrecision, true
+}
+
+// albumIDs returns the spotify IDs of the albums.
+func albumIDs(albums []spotify.SimpleAlbum) []string {
+	ids := make([]string, 0, len(albums))
+	for _, album := range albums {
+		ids = append(ids, album.ID.String())
+	}
+	return ids
+}
+
+// diffAlbums compares the current albums of an artist with the album IDs of the previous sync.
+func diffAlbums(previous []string, current []spotify.SimpleAlbum) (added []spotify.SimpleAlbum, removed []string) {
+	known := make(map[string]bool, len(previous))
+	for _, id := range previous {
+		known[id] = true
+	}
+
+	seen := make(map[string]bool, len(current))
+	for _, album := range current {
+		id := album.ID.String()
+		seen[id] = true
+		if !known[id] {
+			added = append(added, album)
+		}
+	}
+
+	for _, id := range previous {
+		if !seen[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	return added, removed
+}
+
+// albumsInGroups returns the albums that belong to one of the album groups.
+func albumsInGroups(albums []spotify.SimpleAlbum, groups []string) []spotify.SimpleAlbum {
+	var result []spotify.SimpleAlbum
+	for _, album := range albums {
+		if slices.Contains(groups, album.AlbumGroup) {
+			result = append(result, album)
+		}
+	}
+	return result
+}
+
+// artistServiceAlbums returns the albums that belong in the artist's artist-service record. appears_on albums are by
+// other artists, and the artist-service has no album group to tell them apart, so they are left out.
+func artistServiceAlbums(albums []spotify.SimpleAlbum) []spotify.SimpleAlbum {
+	var result []spotify.SimpleAlbum
+	for _, album := range albums {
+		if album.AlbumGroup != "appears_on" {
+			result = append(result, album)
+		}
+	}
+	return result
+}
+
+// newReleaseWindow returns how recently an album must have been released to count as new on the first sync of an
+// artist, 7 days unless configured otherwise with NEW_RELEASE_WINDOW.
+func newReleaseWindow() time.Duration {
+	window, err := time.ParseDuration(os.Getenv("NEW_RELEASE_WINDOW"))
+	if err != nil {
+		return 7 * 24 * time.Hour
+	}
+	return window
+}
+
+// recentAlbums returns the albums released within the window. Only release dates known to the day are considered,
+// as a year or month alone can't tell whether an album is new.
+func recentAlbums(albums []spotify.SimpleAlbum, window time.Duration) []spotify.SimpleAlbum {
+	var recent []spotify.SimpleAlbum
+	for _, album := range albums {
+		date, precision, ok := releaseDate(album)
+		if ok && precision == "day" && time.Since(date) < window {
+			recent = append(recent, album)
+		}
+	}
+	return recent
+}
+
+// publishNewReleases publishes a new-release event for every album that was added since the artist's last sync.
+func publishNewReleases(ctx context.Context, artistID string, spotifyID string, added []spotify.SimpleAlbum) {
+	for _, album := range added {
+		event := events.NewReleaseEvent{
+			ArtistID:       artistID,
+			SpotifyID:      spotifyID,
+			SpotifyAlbumID: album.ID.String(),
+			Name:           album.Name,
+			AlbumType:      album.AlbumType,
+			AlbumGroup:     album.AlbumGroup,
+			DetectedAt:     time.Now(),
+		}
+		if _, precision, ok := releaseDate(album); ok {
+			event.ReleaseDate = album.ReleaseDate
+			event.ReleaseDatePrecision = precision
+		}
+
+		err := events.Publish(ctx, events.NewRelease, event)
+		if err != nil {
+			zap.L().Error("Failed to publish new release event", zap.String("album", album.ID.String()), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+func TestAlbumGroupNames(t *testing.T) {
+	names := albumGroupNames([]spotify.AlbumType{spotify.AlbumTypeSingle, spotify.AlbumTypeAppearsOn, spotify.AlbumTypeAlbum})
+	if want := []string{"album", "appears_on", "single"}; !slices.Equal(names, want) {
+		t.Errorf("names = %v, want %v", names, want)
+	}
+}
+
+func TestAlbumsInGroups(t *testing.T) {
+	albums := []spotify.SimpleAlbum{
+		{ID: "1", AlbumGroup: "album"},
+		{ID: "2", AlbumGroup: "appears_on"},
+		{ID: "3", AlbumGroup: "single"},
+	}
+
+	result := albumsInGroups(albums, []string{"album", "single"})
+	if ids := albumIDs(result); !slices.Equal(ids, []string{"1", "3"}) {
+		t.Errorf("albums = %v, want the albums of the previously synced groups", ids)
+	}
+}
+
+func TestArtistServiceAlbumsLeavesOutAppearsOn(t *testing.T) {
+	albums := []spotify.SimpleAlbum{
+		{ID: "1", AlbumGroup: "album"},
+		{ID: "2", AlbumGroup: "appears_on"},
+		{ID: "3", AlbumGroup: "compilation"},
+	}
+
+	if ids := albumIDs(artistServiceAlbums(albums)); !slices.Equal(ids, []string{"1", "3"}) {
+		t.Errorf("albums = %v, want the albums without appears_on", ids)
+	}
+}
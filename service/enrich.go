@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Fan-Fuse/spotify-service/proto"
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+)
+
+// spotifyAPIURL is the base URL of the Spotify Web API.
+const spotifyAPIURL = "https://api.spotify.com/v1/"
+
+// albumBatchSize is the maximum number of albums Spotify returns per GetAlbums request.
+const albumBatchSize = 20
+
+// albumDetails is a full album including the label, which spotify.FullAlbum doesn't decode.
+type albumDetails struct {
+	spotify.FullAlbum
+	Label string `json:"label"`
+}
+
+// albumEnrichment reports whether releases returned by GetReleasesForArtist are enriched with their full album
+// details, which costs an extra request per 20 albums. It is disabled unless ENRICH_ALBUMS is set.
+func albumEnrichment() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("ENRICH_ALBUMS"))
+	return enabled
+}
+
+// getAlbumDetails fetches the full details of the albums in batches, keyed by their spotify ID.
+// It returns nil if album enrichment is disabled.
+func getAlbumDetails(ctx context.Context, albums []spotify.SimpleAlbum) (map[spotify.ID]*albumDetails, error) {
+	if !albumEnrichment() {
+		return nil, nil
+	}
+
+	details := make(map[spotify.ID]*albumDetails, len(albums))
+	for start := 0; start < len(albums); start += albumBatchSize {
+		end := min(start+albumBatchSize, len(albums))
+
+		ids := make([]string, 0, end-start)
+		for _, album := range albums[start:end] {
+			ids = append(ids, album.ID.String())
+		}
+
+		zap.L().Info("Getting album details", zap.Int("albums", len(ids)))
+		fullAlbums, err := getAlbums(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		// Albums Spotify doesn't know anymore are returned as nil
+		for _, album := range fullAlbums {
+			if album != nil {
+				details[album.ID] = album
+			}
+		}
+	}
+
+	return details, nil
+}
+
+// getAlbums fetches up to 20 albums with the app credentials. It calls the API directly instead of
+// spotify.Client.GetAlbums, so the label is decoded as well.
+func getAlbums(ctx context.Context, ids []string) ([]*albumDetails, error) {
+	query := url.Values{"ids": {strings.Join(ids, ",")}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, spotifyAPIURL+"albums?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := getAppHTTPClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeSpotifyError(resp)
+	}
+
+	var result struct {
+		Albums []*albumDetails `json:"albums"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Albums, nil
+}
+
+// decodeSpotifyError decodes the error of a failed spotify response, like spotify.Client does.
+func decodeSpotifyError(resp *http.Response) error {
+	var body struct {
+		Error spotify.Error `json:"error"`
+	}
+	err := json.NewDecoder(resp.Body).Decode(&body)
+	if err != nil || body.Error.Message == "" {
+		body.Error.Message = fmt.Sprintf("spotify: unexpected HTTP %d: %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	body.Error.Status = resp.StatusCode
+
+	return body.Error
+}
+
+// albumUPC returns the UPC barcode of an album, or an empty string if it has none.
+func albumUPC(album *albumDetails) string {
+	return album.ExternalIDs["upc"]
+}
+
+// buildCopyrights converts spotify copyrights to their proto representation.
+func buildCopyrights(copyrights []spotify.Copyright) []*proto.SpotifyCopyright {
+	var result []*proto.SpotifyCopyright
+	for _, copyright := range copyrights {
+		result = append(result, &proto.SpotifyCopyright{
+			Text: copyright.Text,
+			Type: copyright.Type,
+		})
+	}
+	return result
+}
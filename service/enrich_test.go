@@ -0,0 +1,63 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+func TestAlbumDetailsDecodesLabel(t *testing.T) {
+	body := `{
+		"id": "4aawyAB9vmqN3uQ7FjRGTy",
+		"name": "Global Warming",
+		"label": "Mr.305/Polo Grounds Music/RCA Records",
+		"popularity": 57,
+		"genres": ["latin"],
+		"copyrights": [{"text": "(P) 2012 RCA Records", "type": "P"}],
+		"external_ids": {"upc": "886443671584"}
+	}`
+
+	var album albumDetails
+	if err := json.Unmarshal([]byte(body), &album); err != nil {
+		t.Fatal(err)
+	}
+
+	if album.ID != "4aawyAB9vmqN3uQ7FjRGTy" || album.Name != "Global Warming" {
+		t.Errorf("album = %s %q, want the simple album fields decoded", album.ID, album.Name)
+	}
+	if album.Label != "Mr.305/Polo Grounds Music/RCA Records" {
+		t.Errorf("label = %q", album.Label)
+	}
+	if album.Popularity != 57 || len(album.Genres) != 1 || len(album.Copyrights) != 1 {
+		t.Errorf("popularity = %d, genres = %v, copyrights = %v", album.Popularity, album.Genres, album.Copyrights)
+	}
+	if upc := albumUPC(&album); upc != "886443671584" {
+		t.Errorf("upc = %q", upc)
+	}
+}
+
+func TestDecodeSpotifyError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.WriteHeader(http.StatusNotFound)
+	rec.WriteString(`{"error": {"status": 404, "message": "Non existing id"}}`)
+
+	var spotifyErr spotify.Error
+	if err := decodeSpotifyError(rec.Result()); !errors.As(err, &spotifyErr) {
+		t.Fatalf("err = %v, want a spotify.Error", err)
+	}
+	if spotifyErr.Status != http.StatusNotFound || spotifyErr.Message != "Non existing id" {
+		t.Errorf("err = %+v", spotifyErr)
+	}
+
+	// Errors without a JSON body still carry the status
+	rec = httptest.NewRecorder()
+	rec.WriteHeader(http.StatusBadGateway)
+
+	if err := decodeSpotifyError(rec.Result()); !errors.As(err, &spotifyErr) || spotifyErr.Status != http.StatusBadGateway {
+		t.Errorf("err = %v, want a spotify.Error with status %d", err, http.StatusBadGateway)
+	}
+}
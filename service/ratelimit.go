@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// maxRateLimitRetries is how often a single request is retried after a 429 response.
+	maxRateLimitRetries = 5
+	// rateLimitBaseBackoff is the backoff before the first retry, it doubles with every retry.
+	rateLimitBaseBackoff = time.Second
+	// rateLimitMaxBackoff caps the backoff of a single retry.
+	rateLimitMaxBackoff = 30 * time.Second
+	// maxSyncRetryWait caps the total time a single sync may spend waiting on rate limits.
+	maxSyncRetryWait = 2 * time.Minute
+)
+
+var (
+	throttleEvents    atomic.Int64
+	throttleWaitNanos atomic.Int64
+
+	// spotifyRequests bounds the number of concurrent spotify requests across the whole process,
+	// so parallel syncs don't run into rate limiting.
+	spotifyRequests = make(chan struct{}, maxConcurrentRequests())
+)
+
+// maxConcurrentRequests returns how many spotify requests may be in flight at once, 8 unless configured otherwise.
+func maxConcurrentRequests() int {
+	n, err := strconv.Atoi(os.Getenv("SPOTIFY_MAX_CONCURRENT_REQUESTS"))
+	if err != nil || n < 1 {
+		return 8
+	}
+	return n
+}
+
+// ThrottleStats returns how often spotify rate limited us and the total time spent waiting since startup.
+func ThrottleStats() (events int64, wait time.Duration) {
+	return throttleEvents.Load(), time.Duration(throttleWaitNanos.Load())
+}
+
+// retryBudget tracks how much time a sync may still spend waiting on rate limits.
+type retryBudget struct {
+	mu        sync.Mutex
+	remaining time.Duration
+}
+
+// take reserves d from the budget, returning false if the budget doesn't allow it.
+func (b *retryBudget) take(d time.Duration) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if d > b.remaining {
+		return false
+	}
+	b.remaining -= d
+	return true
+}
+
+type retryBudgetKey struct{}
+
+// withRetryBudget attaches a rate limit wait budget to the context, unless it already carries one.
+// Nested calls, like HandleSpotifyArtist within HandleSpotifyUser, share the budget of the outermost sync.
+func withRetryBudget(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(retryBudgetKey{}).(*retryBudget); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, retryBudgetKey{}, &retryBudget{remaining: maxSyncRetryWait})
+}
+
+// rateLimitTransport retries requests spotify answered with 429 Too Many Requests,
+// honouring the Retry-After header and backing off exponentially with jitter.
+type rateLimitTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	budget, _ := ctx.Value(retryBudgetKey{}).(*retryBudget)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+			return resp, err
+		}
+
+		// Requests with a body can only be retried if the body can be recreated
+		if attempt >= maxRateLimitRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		wait := rateLimitBackoff(attempt, resp.Header.Get("Retry-After"))
+		throttleEvents.Add(1)
+		zap.L().Warn("Spotify rate limit hit",
+			zap.String("path", req.URL.Path),
+			zap.Int("attempt", attempt+1),
+			zap.Duration("wait", wait),
+			zap.Int64("events", throttleEvents.Load()),
+			zap.Duration("wait_total", time.Duration(throttleWaitNanos.Load())),
+		)
+
+		if budget != nil && !budget.take(wait) {
+			zap.L().Warn("Spotify rate limit wait budget exhausted", zap.String("path", req.URL.Path), zap.Duration("wait", wait))
+			return resp, nil
+		}
+		// Without a budget, like on the gRPC paths, a single wait may not exceed the backoff cap
+		if budget == nil && wait > rateLimitMaxBackoff {
+			zap.L().Warn("Spotify rate limit wait too long", zap.String("path", req.URL.Path), zap.Duration("wait", wait))
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		throttleWaitNanos.Add(int64(wait))
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// roundTrip sends a single request once one of the process wide request slots is free.
+func (t *rateLimitTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case spotifyRequests <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-spotifyRequests }()
+
+	return t.base.RoundTrip(req)
+}
+
+// rateLimitBackoff returns how long to wait before the next attempt, which is the jittered exponential backoff,
+// but at least the Retry-After spotify asked for.
+func rateLimitBackoff(attempt int, retryAfter string) time.Duration {
+	backoff := rateLimitBaseBackoff << attempt
+	if backoff > rateLimitMaxBackoff {
+		backoff = rateLimitMaxBackoff
+	}
+	// Jitter between half and the full backoff, so concurrent syncs don't retry in lockstep
+	backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if wait := time.Duration(seconds) * time.Second; wait > backoff {
+			return wait
+		}
+	}
+	return backoff
+}
+
+// newHTTPClient creates an http client that authenticates with the token source and handles rate limiting.
+func newHTTPClient(source oauth2.TokenSource) *http.Client {
+	return &http.Client{
+		Transport: &rateLimitTransport{
+			base: &oauth2.Transport{Source: source, Base: http.DefaultTransport},
+		},
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRateLimitedServer returns a server that answers the first limited requests with 429 and the given Retry-After,
+// and every request after that with 200. requests counts all requests the server received.
+func newRateLimitedServer(t *testing.T, limited int64, retryAfter string, requests *atomic.Int64) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= limited {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// get sends a GET request through a rateLimitTransport.
+func get(t *testing.T, ctx context.Context, url string) (*http.Response, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: &rateLimitTransport{base: http.DefaultTransport}}
+	return client.Do(req)
+}
+
+func TestRateLimitTransportHonoursRetryAfter(t *testing.T) {
+	var requests atomic.Int64
+	server := newRateLimitedServer(t, 1, "1", &requests)
+
+	start := time.Now()
+	resp, err := get(t, withRetryBudget(context.Background()), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least the Retry-After of 1s", elapsed)
+	}
+}
+
+func TestRateLimitTransportBudgetExhausted(t *testing.T) {
+	var requests atomic.Int64
+	server := newRateLimitedServer(t, 10, "30", &requests)
+
+	// A budget smaller than the Retry-After returns the 429 right away instead of waiting
+	ctx := context.WithValue(context.Background(), retryBudgetKey{}, &retryBudget{remaining: time.Second})
+	start := time.Now()
+	resp, err := get(t, ctx, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("waited %s despite the exhausted budget", elapsed)
+	}
+}
+
+func TestRateLimitTransportWithoutBudgetCapsRetryAfter(t *testing.T) {
+	var requests atomic.Int64
+	server := newRateLimitedServer(t, 10, "3600", &requests)
+
+	// Without a budget a Retry-After beyond rateLimitMaxBackoff returns the 429 instead of waiting for it
+	start := time.Now()
+	resp, err := get(t, context.Background(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("waited %s for a Retry-After beyond the backoff cap", elapsed)
+	}
+}
+
+func TestRateLimitTransportCancelledWhileWaiting(t *testing.T) {
+	var requests atomic.Int64
+	server := newRateLimitedServer(t, 10, "30", &requests)
+
+	ctx, cancel := context.WithTimeout(withRetryBudget(context.Background()), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := get(t, ctx, server.URL)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("returned after %s, want right after the cancellation", elapsed)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestRetryBudgetIsShared(t *testing.T) {
+	ctx := withRetryBudget(context.Background())
+	nested := withRetryBudget(ctx)
+
+	outer := ctx.Value(retryBudgetKey{}).(*retryBudget)
+	inner := nested.Value(retryBudgetKey{}).(*retryBudget)
+	if outer != inner {
+		t.Fatal("nested sync got its own budget")
+	}
+
+	if !inner.take(maxSyncRetryWait) {
+		t.Fatal("taking the whole budget failed")
+	}
+	if outer.take(time.Second) {
+		t.Error("took from a budget the nested sync already used up")
+	}
+}
+
+func TestRateLimitTransportBoundsConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			highest := maxInFlight.Load()
+			if n <= highest || maxInFlight.CompareAndSwap(highest, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3*cap(spotifyRequests); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := get(t, context.Background(), server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got > int64(cap(spotifyRequests)) {
+		t.Errorf("%d concurrent requests, want at most %d", got, cap(spotifyRequests))
+	}
+}
+
+func TestRateLimitBackoff(t *testing.T) {
+	for attempt := 0; attempt < 8; attempt++ {
+		backoff := rateLimitBaseBackoff << attempt
+		if backoff > rateLimitMaxBackoff {
+			backoff = rateLimitMaxBackoff
+		}
+
+		wait := rateLimitBackoff(attempt, "")
+		if wait < backoff/2 || wait > backoff {
+			t.Errorf("attempt %d: wait = %s, want between %s and %s", attempt, wait, backoff/2, backoff)
+		}
+	}
+
+	if wait := rateLimitBackoff(0, "45"); wait != 45*time.Second {
+		t.Errorf("wait = %s, want the Retry-After of 45s", wait)
+	}
+}
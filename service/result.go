@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+// ErrAllArtistsFailed is returned when every artist of a user sync failed.
+var ErrAllArtistsFailed = errors.New("all artists failed to sync")
+
+// ArtistFailure describes an artist that failed to sync.
+type ArtistFailure struct {
+	ID     string
+	Reason string
+}
+
+// UserSyncResult is the outcome of syncing the artists of a single user.
+// It is safe to fill from the concurrent artist syncs.
+type UserSyncResult struct {
+	UserID    string
+	Succeeded []string
+	Failed    []ArtistFailure
+	Skipped   []string
+	// Fresh are the artists that were skipped because they were synced recently
+	Fresh []string
+
+	mu sync.Mutex
+}
+
+func (r *UserSyncResult) succeed(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Succeeded = append(r.Succeeded, id)
+}
+
+func (r *UserSyncResult) fail(id string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Failed = append(r.Failed, ArtistFailure{ID: id, Reason: err.Error()})
+}
+
+func (r *UserSyncResult) skip(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Skipped = append(r.Skipped, id)
+}
+
+func (r *UserSyncResult) fresh(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Fresh = append(r.Fresh, id)
+}
+
+// Total returns the number of artists the sync looked at.
+func (r *UserSyncResult) Total() int {
+	return len(r.Succeeded) + len(r.Failed) + len(r.Skipped) + len(r.Fresh)
+}
+
+// Log writes a summary of the result to the logger.
+func (r *UserSyncResult) Log() {
+	throttled, throttleWait := ThrottleStats()
+	zap.L().Info("User sync finished",
+		zap.String("user", r.UserID),
+		zap.Int("succeeded", len(r.Succeeded)),
+		zap.Int("failed", len(r.Failed)),
+		zap.Int("skipped", len(r.Skipped)),
+		zap.Int("fresh", len(r.Fresh)),
+		zap.Int64("throttled_total", throttled),
+		zap.Duration("throttle_wait_total", throttleWait),
+	)
+	for _, failure := range r.Failed {
+		zap.L().Warn("Artist failed to sync", zap.String("user", r.UserID), zap.String("artist", failure.ID), zap.String("reason", failure.Reason))
+	}
+}
+
+// isFatal reports whether an error makes it pointless to continue syncing the remaining artists,
+// e.g. because the user's token was revoked or the sync was cancelled.
+func isFatal(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return IsAuthError(err)
+}
+
+// IsAuthError reports whether spotify rejected our credentials, either as a 401 response or when fetching a token.
+// Retrying doesn't help until the credentials are fixed.
+func IsAuthError(err error) bool {
+	// Outages of the token endpoint are worth retrying, rejected grants like a revoked token aren't
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		return retrieveErr.Response == nil || retrieveErr.Response.StatusCode < http.StatusInternalServerError
+	}
+
+	var spotifyErr spotify.Error
+	if errors.As(err, &spotifyErr) {
+		return spotifyErr.Status == http.StatusUnauthorized
+	}
+
+	return false
+}
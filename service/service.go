@@ -2,148 +2,402 @@ package service
 
 import (
 	"context"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	artistProto "github.com/Fan-Fuse/artist-service/proto"
 	"github.com/Fan-Fuse/spotify-service/clients"
+	"github.com/Fan-Fuse/spotify-service/events"
+	"github.com/Fan-Fuse/spotify-service/store"
+	userProto "github.com/Fan-Fuse/user-service/proto"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zmb3/spotify/v2"
-	spotifyauth "github.com/zmb3/spotify/v2/auth"
 )
 
+// artistSyncs coalesces concurrent syncs of the same artist.
+var artistSyncs singleflight.Group
+
+// HandleSpotifyArtist fetches an artist and their albums from Spotify and creates the artist in the artist-service.
+// If client is nil, a client authenticated with the app credentials is used.
+// Concurrent calls for the same artist share a single sync and its result.
 func HandleSpotifyArtist(ctx context.Context, spotifyID string, client *spotify.Client) error {
-	// Check if we already have a client (this happens when we call this function from the user handling)
-	if client == nil {
+	for {
+		// led is only set if this call runs the sync instead of joining another one
+		led := false
+		ch := artistSyncs.DoChan(spotifyID, func() (interface{}, error) {
+			led = true
+			return nil, handleSpotifyArtist(ctx, spotifyID, client)
+		})
 
-		// Create an oauth token
-		config := &clientcredentials.Config{
-			ClientID:     os.Getenv("SPOTIFY_ID"),
-			ClientSecret: os.Getenv("SPOTIFY_SECRET"),
-			TokenURL:     spotifyauth.TokenURL,
-		}
-		token, err := config.Token(ctx)
-		if err != nil {
-			return err
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case res := <-ch:
+			// The sync we joined failed for reasons specific to its caller, like a cancelled context or a revoked
+			// user token, so we run it again ourselves
+			if !led && isFatal(res.Err) && ctx.Err() == nil {
+				zap.L().Info("Shared artist sync aborted, retrying", zap.String("artist", spotifyID), zap.Error(res.Err))
+				continue
+			}
+			return res.Err
 		}
+	}
+}
 
-		httpClient := spotifyauth.New().Client(ctx, token)
-		client = spotify.New(httpClient)
+// handleSpotifyArtist runs a single sync for HandleSpotifyArtist.
+func handleSpotifyArtist(ctx context.Context, spotifyID string, client *spotify.Client) error {
+	if !startSync() {
+		return ErrShuttingDown
 	}
+	defer syncs.Done()
+
+	ctx = withRetryBudget(ctx)
+	start := time.Now()
+
+	// Check if we already have a client (this happens when we call this function from the user handling)
+	if client == nil {
+		client = getAppClient()
+	}
+
+	state := loadArtistState(spotifyID)
+	err := syncArtist(ctx, spotifyID, client, state, start)
+	saveArtistState(state, time.Since(start), err)
+
+	return err
+}
+
+// syncArtist runs the sync for HandleSpotifyArtist, updating state with what was synced.
+func syncArtist(ctx context.Context, spotifyID string, client *spotify.Client, state *store.ArtistState, start time.Time) error {
 	// Next, get the artist
 	artist, err := client.GetArtist(ctx, spotify.ID(spotifyID))
 	if err != nil {
 		return err
 	}
 
-	// Build the images
-	var images []*artistProto.Image
-	for _, image := range artist.Images {
-		images = append(images, &artistProto.Image{
-			Height: int32(image.Height),
-			Width:  int32(image.Width),
-			Url:    image.URL,
-		})
-	}
-
 	// Retrieve all the albums for the artist
-	albumTypes := []spotify.AlbumType{spotify.AlbumTypeAlbum, spotify.AlbumTypeSingle, spotify.AlbumTypeCompilation}
-	albums, err := client.GetArtistAlbums(ctx, spotify.ID(spotifyID), albumTypes, spotify.Limit(50))
+	albumTypes := configuredAlbumGroups()
+	listing, err := getArtistAlbums(ctx, client, spotifyID, albumTypes)
 	if err != nil {
 		return err
 	}
+	albums := simpleAlbums(listing)
+	groups := albumGroupNames(albumTypes)
 
-	// Build the albums
-	var responseAlbums []*artistProto.Album
-	for _, album := range albums.Albums {
-		responseAlbums = append(responseAlbums, &artistProto.Album{
-			Id:          album.ID.String(),
-			Name:        album.Name,
-			ReleaseDate: &timestamppb.Timestamp{Seconds: album.ReleaseDateTime().Unix()},
-			Externals: &artistProto.Externals{
-				Spotify: album.ID.String(),
-			},
-		})
+	// Compare the albums with the last sync. The very first sync of an artist has nothing to compare with, which is
+	// also the case if the sync state was lost, so then only the recently released albums count as new.
+	added, removed := diffAlbums(state.AlbumIDs, albums)
+	if state.LastSyncedAt.IsZero() {
+		added = recentAlbums(albums, newReleaseWindow())
+	} else if state.AlbumGroups != nil && !slices.Equal(state.AlbumGroups, groups) {
+		// The album groups were reconfigured since the last sync. Albums of newly synced groups aren't new releases,
+		// and albums missing now may just be in a group that isn't synced anymore, so only compare the groups that
+		// were synced before and don't report removed albums.
+		zap.L().Info("Album groups changed since last sync", zap.String("artist", spotifyID), zap.Strings("previous", state.AlbumGroups), zap.Strings("current", groups))
+		added = albumsInGroups(added, state.AlbumGroups)
+		removed = nil
 	}
 
-	// Handle pagination
-	for albums.Next != "" {
-		zap.S().Info("Getting next page of albums", zap.String("next", albums.Next))
-		err = client.NextPage(ctx, albums)
+	// Only send the artist to the artist-service if anything changed since the last sync.
+	// TODO: Upsert the artist by its spotify ID once the artist-service can look artists up by it and update them,
+	// until then every change creates a new artist record
+	recorded := artistServiceAlbums(albums)
+	etag := artistETag(artist, recorded)
+	if state.ArtistID != "" && etag == state.ETag {
+		zap.L().Info("Artist unchanged since last sync", zap.String("id", state.ArtistID))
+	} else {
+		id, err := createArtist(ctx, artist, recorded)
 		if err != nil {
 			return err
 		}
+		zap.L().Info("Created artist", zap.String("id", id))
+		state.ArtistID = id
+	}
+	state.ETag = etag
+	state.AlbumIDs = albumIDs(albums)
+	state.AlbumGroups = groups
+
+	if len(added) > 0 || len(removed) > 0 {
+		zap.L().Info("Discography changed", zap.String("artist", spotifyID), zap.Int("added", len(added)), zap.Strings("removed", removed))
+	}
+	publishNewReleases(ctx, state.ArtistID, spotifyID, added)
 
-		for _, album := range albums.Albums {
-			responseAlbums = append(responseAlbums, &artistProto.Album{
-				Id:          album.ID.String(),
-				Name:        album.Name,
-				ReleaseDate: &timestamppb.Timestamp{Seconds: album.ReleaseDateTime().Unix()},
-				Externals: &artistProto.Externals{
-					Spotify: album.ID.String(),
-				},
-			})
+	err = events.Publish(ctx, events.ArtistSynced, events.ArtistSyncedEvent{
+		ArtistID:      state.ArtistID,
+		SpotifyID:     spotifyID,
+		Albums:        len(albums),
+		AddedAlbums:   len(added),
+		RemovedAlbums: len(removed),
+		DurationMs:    time.Since(start).Milliseconds(),
+		SyncedAt:      time.Now(),
+	})
+	if err != nil {
+		zap.L().Error("Failed to publish artist synced event", zap.String("artist", spotifyID), zap.Error(err))
+	}
+
+	return nil
+}
+
+// createArtist creates the artist with their albums in the artist-service and returns the fanfuse artist id.
+func createArtist(ctx context.Context, artist *spotify.FullArtist, albums []spotify.SimpleAlbum) (string, error) {
+	// Build the albums
+	var responseAlbums []*artistProto.Album
+	for _, album := range albums {
+		responseAlbum := &artistProto.Album{
+			Id:   album.ID.String(),
+			Name: album.Name,
+			Externals: &artistProto.Externals{
+				Spotify: album.ID.String(),
+			},
 		}
+		// The artist-service only stores a timestamp, which would turn a year or month into a made up day.
+		// So the release date is only set if it is known to the day.
+		if date, precision, ok := releaseDate(album); ok && precision == "day" {
+			responseAlbum.ReleaseDate = timestamppb.New(date)
+		}
+		responseAlbums = append(responseAlbums, responseAlbum)
 	}
 
 	// Create the artist
-	id, err := clients.CreateArtist(&artistProto.Artist{
+	id, err := clients.CreateArtist(ctx, &artistProto.Artist{
 		Name:      artist.Name,
-		Images:    images,
+		Images:    buildArtistImages(artist.Images),
 		Albums:    responseAlbums,
 		Externals: &artistProto.Externals{Spotify: artist.ID.String()},
 	})
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	zap.S().Info("Created artist", zap.String("id", id.Id))
+	return id.Id, nil
+}
 
-	return nil
+// buildArtistImages converts spotify images to their artist-service representation.
+func buildArtistImages(images []spotify.Image) []*artistProto.Image {
+	var result []*artistProto.Image
+	for _, image := range images {
+		result = append(result, &artistProto.Image{
+			Height: int32(image.Height),
+			Width:  int32(image.Width),
+			Url:    image.URL,
+		})
+	}
+	return result
+}
+
+// HandleSpotifyUser syncs every artist the given fanfuse user follows on Spotify.
+// A failing artist does not stop the sync; the returned result lists which artists succeeded, failed or were skipped.
+// An error is only returned if the sync failed as a whole, either fatally or because every artist failed.
+// Artists that were synced within the freshness window are skipped.
+func HandleSpotifyUser(ctx context.Context, userId string) (*UserSyncResult, error) {
+	if !startSync() {
+		return nil, ErrShuttingDown
+	}
+	defer syncs.Done()
+
+	start := time.Now()
+	result, err := syncUser(withRetryBudget(ctx), userId)
+
+	state := loadUserState(userId)
+	state.Artists = result.Total()
+	saveUserState(state, time.Since(start), err)
+
+	// Announce the outcome of the sync
+	event := events.UserSyncEvent{
+		UserID:     userId,
+		Succeeded:  len(result.Succeeded),
+		Failed:     len(result.Failed),
+		Skipped:    len(result.Skipped),
+		Fresh:      len(result.Fresh),
+		DurationMs: time.Since(start).Milliseconds(),
+		SyncedAt:   time.Now(),
+	}
+	routingKey := events.UserSynced
+	if err != nil {
+		routingKey = events.UserSyncFailed
+		event.Error = err.Error()
+	}
+
+	// The sync might have been cancelled, the event should still go out
+	if pubErr := events.Publish(context.WithoutCancel(ctx), routingKey, event); pubErr != nil {
+		zap.L().Error("Failed to publish user sync event", zap.String("user", userId), zap.Error(pubErr))
+	}
+
+	return result, err
 }
 
-func HandleSpotifyUser(ctx context.Context, userId string) error {
+// syncUser runs the sync for HandleSpotifyUser.
+func syncUser(ctx context.Context, userId string) (*UserSyncResult, error) {
+	result := &UserSyncResult{UserID: userId}
+
 	// First, get the user we want to get the artist for
 	user, err := clients.GetUser(userId)
 	if err != nil {
-		zap.S().Error("Failed to get user", zap.Error(err))
-		return err
+		zap.L().Error("Failed to get user", zap.Error(err))
+		return result, err
+	}
+
+	// Next, get the artists the user follows using their spotify token
+	client := newUserClient(user)
+	responseArtists, err := getFollowedArtists(ctx, client)
+	if err != nil {
+		zap.L().Error("Failed to get followed artists", zap.Error(err))
+		return result, err
+	}
+
+	// run a "HandleSpotifyArtist" for each artist, a few of them in parallel
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(artistConcurrency())
+	for _, artist := range responseArtists {
+		// Once a fatal error occurred, the remaining artists are skipped
+		if gctx.Err() != nil {
+			result.skip(artist)
+			continue
+		}
+
+		// Artists synced recently, e.g. for another user following them, aren't fetched again
+		if isFresh(artist) {
+			result.fresh(artist)
+			continue
+		}
+
+		artist := artist
+		g.Go(func() error {
+			err := HandleSpotifyArtist(gctx, artist, client)
+			if err == nil {
+				result.succeed(artist)
+				return nil
+			}
+
+			// The artist was aborted because another artist failed fatally
+			if gctx.Err() != nil {
+				result.skip(artist)
+				return nil
+			}
+
+			zap.L().Error("Failed to handle artist", zap.String("artist", artist), zap.Error(err))
+			result.fail(artist, err)
+			if isFatal(err) {
+				// Returning the error cancels the remaining artists
+				return err
+			}
+			return nil
+		})
+	}
+	fatalErr := g.Wait()
+	if fatalErr == nil {
+		fatalErr = ctx.Err()
+	}
+
+	result.Log()
+
+	if fatalErr != nil {
+		return result, fatalErr
+	}
+	if len(result.Failed) > 0 && len(result.Succeeded) == 0 && len(result.Fresh) == 0 {
+		return result, ErrAllArtistsFailed
 	}
 
-	// Create an oauth token from the user's access token
+	return result, nil
+}
+
+// artistConcurrency returns how many artists of a user are synced in parallel, 4 unless configured otherwise.
+func artistConcurrency() int {
+	n, err := strconv.Atoi(os.Getenv("ARTIST_CONCURRENCY"))
+	if err != nil || n < 1 {
+		return 4
+	}
+	return n
+}
+
+// newUserClient creates a spotify client authenticated with the user's access token.
+// The user-service only stores the access token, so it can't be refreshed here once it expires.
+func newUserClient(user *userProto.GetUserResponse) *spotify.Client {
 	token := &oauth2.Token{
 		AccessToken: user.SpotifyUser.AccessToken,
 		TokenType:   "Bearer",
 	}
+	return spotify.New(newHTTPClient(oauth2.StaticTokenSource(token)))
+}
 
-	// Next, get the artist from the user's library using their spotify ID
-	httpClient := spotifyauth.New().Client(ctx, token)
-	client := spotify.New(httpClient)
-	artists, err := client.CurrentUsersFollowedArtists(ctx)
-	if err != nil {
-		zap.S().Error("Failed to get followed artists", zap.Error(err))
-		return err
-	}
+// getFollowedArtists returns the spotify IDs of all artists the client's user follows, following the cursor pagination.
+func getFollowedArtists(ctx context.Context, client *spotify.Client) ([]string, error) {
+	var artistIDs []string
+	opts := []spotify.RequestOption{spotify.Limit(50)}
+	for {
+		artists, err := client.CurrentUsersFollowedArtists(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		// Build an array of artist IDs
+		for _, artist := range artists.Artists {
+			artistIDs = append(artistIDs, artist.ID.String())
+		}
+
+		// The cursor is empty on the last page
+		if artists.Next == "" || artists.Cursor.After == "" {
+			break
+		}
 
-	// Build an array of artist IDs
-	var responseArtists []string
-	for _, artist := range artists.Artists {
-		responseArtists = append(responseArtists, artist.ID.String())
+		zap.L().Info("Getting next page of followed artists", zap.String("after", artists.Cursor.After))
+		opts = []spotify.RequestOption{spotify.Limit(50), spotify.After(artists.Cursor.After)}
 	}
 
-	// TODO: Handle pagination
+	return artistIDs, nil
+}
 
-	// run a "HandleSpotifyArtist" for each artist
-	for _, artist := range responseArtists {
-		err = HandleSpotifyArtist(ctx, artist, client)
+// artistAlbum is an album of an artist's album listing including the total tracks, which spotify.SimpleAlbum doesn't
+// decode.
+type artistAlbum struct {
+	spotify.SimpleAlbum
+	TotalTracks int `json:"total_tracks"`
+}
+
+// artistAlbumPage is a page of an artist's album listing. It embeds spotify.SimpleAlbumPage so spotify.Client.NextPage
+// can fetch it, but decodes the items as artistAlbum.
+type artistAlbumPage struct {
+	spotify.SimpleAlbumPage
+	Albums []artistAlbum `json:"items"`
+}
+
+// getArtistAlbums returns all albums of an artist in the given album groups, following pagination.
+func getArtistAlbums(ctx context.Context, client *spotify.Client, spotifyID string, groups []spotify.AlbumType) ([]artistAlbum, error) {
+	// spotify.Client.GetArtistAlbums would decode the first page as spotify.SimpleAlbum, so every page is fetched
+	// through NextPage, starting with the URL of the first one
+	query := url.Values{"limit": {"50"}}
+	if len(groups) > 0 {
+		query.Set("include_groups", strings.Join(albumGroupNames(groups), ","))
+	}
+	albums := &artistAlbumPage{}
+	albums.Next = spotifyAPIURL + "artists/" + spotifyID + "/albums?" + query.Encode()
+
+	var result []artistAlbum
+	for albums.Next != "" {
+		zap.L().Info("Getting page of albums", zap.String("next", albums.Next))
+		err := client.NextPage(ctx, albums)
 		if err != nil {
-			zap.S().Error("Failed to handle artist", zap.Error(err))
-			return err
+			return nil, err
 		}
+		result = append(result, albums.Albums...)
 	}
 
-	return nil
+	return result, nil
+}
+
+// simpleAlbums returns the spotify.SimpleAlbum of each album.
+func simpleAlbums(albums []artistAlbum) []spotify.SimpleAlbum {
+	result := make([]spotify.SimpleAlbum, 0, len(albums))
+	for _, album := range albums {
+		result = append(result, album.SimpleAlbum)
+	}
+	return result
 }
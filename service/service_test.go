@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zmb3/spotify/v2"
+)
+
+// roundTripFunc answers the requests of an http.Client without a server.
+type roundTripFunc func(req *http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func TestGetArtistAlbumsDecodesTotalTracks(t *testing.T) {
+	pages := map[string]string{
+		"": `{
+			"items": [{"id": "1", "name": "First", "album_group": "album", "total_tracks": 12}],
+			"next": "https://api.spotify.com/v1/artists/artist/albums?offset=1"
+		}`,
+		"1": `{
+			"items": [{"id": "2", "name": "Second", "album_group": "single", "total_tracks": 1}],
+			"next": null
+		}`,
+	}
+
+	var groups []string
+	client := spotify.New(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		if offset := req.URL.Query().Get("offset"); offset == "" {
+			groups = append(groups, req.URL.Query().Get("include_groups"))
+		}
+		body, ok := pages[req.URL.Query().Get("offset")]
+		if !ok {
+			t.Errorf("unexpected request %s", req.URL)
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})})
+
+	albums, err := getArtistAlbums(context.Background(), client, "artist", []spotify.AlbumType{spotify.AlbumTypeSingle, spotify.AlbumTypeAlbum})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(albums) != 2 {
+		t.Fatalf("albums = %+v, want both pages", albums)
+	}
+	if albums[0].ID != "1" || albums[0].AlbumGroup != "album" || albums[0].TotalTracks != 12 {
+		t.Errorf("albums[0] = %+v", albums[0])
+	}
+	if albums[1].ID != "2" || albums[1].TotalTracks != 1 {
+		t.Errorf("albums[1] = %+v", albums[1])
+	}
+	if len(groups) != 1 || groups[0] != "album,single" {
+		t.Errorf("include_groups = %v, want [album,single]", groups)
+	}
+}
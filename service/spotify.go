@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Fan-Fuse/spotify-service/clients"
+	"github.com/Fan-Fuse/spotify-service/proto"
+	"github.com/zmb3/spotify/v2"
+)
+
+// GetArtist gets an artist from Spotify by their spotify ID.
+func GetArtist(ctx context.Context, spotifyID string) (*proto.SpotifyArtist, error) {
+	artist, err := getAppClient().GetArtist(ctx, spotify.ID(spotifyID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SpotifyArtist{
+		Id:         artist.ID.String(),
+		Name:       artist.Name,
+		Images:     buildImages(artist.Images),
+		Genres:     artist.Genres,
+		Popularity: int32(artist.Popularity),
+		Followers:  int64(artist.Followers.Count),
+	}, nil
+}
+
+// GetArtistsForUser gets the spotify IDs of the artists a fanfuse user follows.
+func GetArtistsForUser(ctx context.Context, userId string) ([]string, error) {
+	user, err := clients.GetUser(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return getFollowedArtists(ctx, newUserClient(user))
+}
+
+// releaseDatePrecisions maps the release date precisions of Spotify to their proto representation.
+var releaseDatePrecisions = map[string]proto.ReleaseDatePrecision{
+	"year":  proto.ReleaseDatePrecision_RELEASE_DATE_PRECISION_YEAR,
+	"month": proto.ReleaseDatePrecision_RELEASE_DATE_PRECISION_MONTH,
+	"day":   proto.ReleaseDatePrecision_RELEASE_DATE_PRECISION_DAY,
+}
+
+// GetReleasesForArtist gets all releases of an artist in the given album groups from Spotify by their spotify ID.
+// If no album groups are given, the configured ones are used.
+func GetReleasesForArtist(ctx context.Context, spotifyID string, groups []string) ([]*proto.SpotifyRelease, error) {
+	albumTypes := configuredAlbumGroups()
+	if len(groups) > 0 {
+		var err error
+		albumTypes, err = parseAlbumGroups(groups)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client := getAppClient()
+	albums, err := getArtistAlbums(ctx, client, spotifyID, albumTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optionally fetch the full details of the albums
+	details, err := getAlbumDetails(ctx, simpleAlbums(albums))
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []*proto.SpotifyRelease
+	for _, album := range albums {
+		release := &proto.SpotifyRelease{
+			Id:               album.ID.String(),
+			Name:             album.Name,
+			Images:           buildImages(album.Images),
+			ArtistIds:        spotifyArtistIDs(album.Artists),
+			AlbumType:        album.AlbumType,
+			AlbumGroup:       album.AlbumGroup,
+			TotalTracks:      int32(album.TotalTracks),
+			AvailableMarkets: album.AvailableMarkets,
+		}
+		if _, precision, ok := releaseDate(album.SimpleAlbum); ok {
+			release.ReleaseDate = album.ReleaseDate
+			release.ReleaseDatePrecision = releaseDatePrecisions[precision]
+		}
+		if detail := details[album.ID]; detail != nil {
+			release.Genres = detail.Genres
+			release.Upc = albumUPC(detail)
+			release.Copyrights = buildCopyrights(detail.Copyrights)
+			release.Popularity = int32(detail.Popularity)
+			release.Label = detail.Label
+		}
+		releases = append(releases, release)
+	}
+
+	return releases, nil
+}
+
+// ParseArtistID returns the spotify artist ID from either an ID or a URI like "spotify:artist:<id>".
+func ParseArtistID(id string, uri string) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+	if uri == "" {
+		return "", errors.New("neither a spotify artist id nor uri was given")
+	}
+
+	parts := strings.Split(uri, ":")
+	if len(parts) != 3 || parts[0] != "spotify" || parts[1] != "artist" || parts[2] == "" {
+		return "", fmt.Errorf("invalid spotify artist uri %q", uri)
+	}
+	return parts[2], nil
+}
+
+// buildImages converts spotify images to their proto representation.
+func buildImages(images []spotify.Image) []*proto.SpotifyImage {
+	var result []*proto.SpotifyImage
+	for _, image := range images {
+		result = append(result, &proto.SpotifyImage{
+			Url:    image.URL,
+			Width:  int32(image.Width),
+			Height: int32(image.Height),
+		})
+	}
+	return result
+}
+
+// spotifyArtistIDs returns the spotify IDs of the artists.
+func spotifyArtistIDs(artists []spotify.SimpleArtist) []string {
+	var ids []string
+	for _, artist := range artists {
+		ids = append(ids, artist.ID.String())
+	}
+	return ids
+}
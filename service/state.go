@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Fan-Fuse/spotify-service/store"
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+)
+
+var syncState store.SyncStateStore
+
+// ErrShuttingDown is returned for syncs requested after Drain was called.
+var ErrShuttingDown = errors.New("service is shutting down")
+
+var (
+	// syncs tracks the running syncs, shared artist syncs can outlive the handler that started them.
+	syncs sync.WaitGroup
+	// syncsMu guards draining and the syncs.Add calls, so no sync starts while Drain waits.
+	syncsMu  sync.Mutex
+	draining bool
+)
+
+// InitSyncState sets the store the sync state of artists and users is kept in.
+func InitSyncState(s store.SyncStateStore) {
+	syncState = s
+}
+
+// startSync registers a sync that is about to run, returning false once the service is draining.
+func startSync() bool {
+	syncsMu.Lock()
+	defer syncsMu.Unlock()
+
+	if draining {
+		return false
+	}
+	syncs.Add(1)
+	return true
+}
+
+// Drain stops new syncs from starting and waits for the running ones to finish, so the sync state store can be
+// closed safely. It returns ctx.Err() if ctx is done first.
+func Drain(ctx context.Context) error {
+	syncsMu.Lock()
+	draining = true
+	syncsMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		syncs.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loadArtistState returns the stored state of an artist, or a fresh state if there is none.
+// Failing to read the state is not fatal for a sync, so it is only logged.
+func loadArtistState(spotifyID string) *store.ArtistState {
+	state, err := syncState.GetArtist(spotifyID)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			zap.L().Error("Failed to load artist sync state", zap.String("artist", spotifyID), zap.Error(err))
+		}
+		return &store.ArtistState{SpotifyID: spotifyID}
+	}
+	return state
+}
+
+// saveArtistState stores the state of an artist after a sync, recording the error if it failed.
+func saveArtistState(state *store.ArtistState, duration time.Duration, syncErr error) {
+	state.Record(duration, syncErr)
+	if err := syncState.PutArtist(state); err != nil {
+		zap.L().Error("Failed to save artist sync state", zap.String("artist", state.SpotifyID), zap.Error(err))
+	}
+}
+
+// loadUserState returns the stored state of a user, or a fresh state if there is none.
+func loadUserState(userId string) *store.UserState {
+	state, err := syncState.GetUser(userId)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			zap.L().Error("Failed to load user sync state", zap.String("user", userId), zap.Error(err))
+		}
+		return &store.UserState{UserID: userId}
+	}
+	return state
+}
+
+// saveUserState stores the state of a user after a sync, recording the error if it failed.
+func saveUserState(state *store.UserState, duration time.Duration, syncErr error) {
+	state.Record(duration, syncErr)
+	if err := syncState.PutUser(state); err != nil {
+		zap.L().Error("Failed to save user sync state", zap.String("user", state.UserID), zap.Error(err))
+	}
+}
+
+// freshnessWindow returns how long an artist counts as fresh after a successful sync, 6 hours unless configured
+// otherwise. A window of 0 disables skipping fresh artists.
+func freshnessWindow() time.Duration {
+	window, err := time.ParseDuration(os.Getenv("ARTIST_FRESHNESS_WINDOW"))
+	if err != nil {
+		return 6 * time.Hour
+	}
+	return window
+}
+
+// isFresh reports whether the artist was synced successfully within the freshness window.
+func isFresh(spotifyID string) bool {
+	state := loadArtistState(spotifyID)
+	return state.ErrorCount == 0 && !state.LastSyncedAt.IsZero() && time.Since(state.LastSyncedAt) < freshnessWindow()
+}
+
+// artistETag hashes the artist data we send to the artist-service, so unchanged artists can be detected.
+func artistETag(artist *spotify.FullArtist, albums []spotify.SimpleAlbum) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00", artist.Name)
+	for _, image := range artist.Images {
+		fmt.Fprintf(h, "%s\x00", image.URL)
+	}
+	for _, album := range albums {
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00", album.ID, album.Name, album.ReleaseDate)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
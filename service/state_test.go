@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDrainWaitsForRunningSyncs(t *testing.T) {
+	t.Cleanup(func() { draining = false })
+
+	if !startSync() {
+		t.Fatal("startSync refused a sync before draining")
+	}
+
+	// A running sync keeps Drain waiting until ctx is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Drain err = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if startSync() {
+		t.Error("startSync started a sync while draining")
+	}
+
+	syncs.Done()
+	if err := Drain(context.Background()); err != nil {
+		t.Errorf("Drain err = %v after the sync finished", err)
+	}
+}
+
+func TestHandleSpotifyUserWhileDraining(t *testing.T) {
+	t.Cleanup(func() { draining = false })
+
+	if err := Drain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := HandleSpotifyUser(context.Background(), "user"); !errors.Is(err, ErrShuttingDown) {
+		t.Errorf("HandleSpotifyUser err = %v, want %v", err, ErrShuttingDown)
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Fan-Fuse/spotify-service/proto"
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+)
+
+// trackBatchSize is the maximum number of tracks Spotify returns per GetTracks request.
+const trackBatchSize = 50
+
+// GetTracksForRelease gets the tracks of a release from Spotify by its spotify album ID.
+func GetTracksForRelease(ctx context.Context, albumID string) ([]*proto.SpotifyTrack, error) {
+	tracks, err := getAlbumTracks(ctx, getAppClient(), spotify.ID(albumID))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*proto.SpotifyTrack
+	for _, track := range tracks {
+		result = append(result, &proto.SpotifyTrack{
+			Id:                track.ID.String(),
+			Name:              track.Name,
+			Isrc:              trackISRC(track),
+			DurationMs:        int32(track.Duration),
+			Explicit:          track.Explicit,
+			DiscNumber:        int32(track.DiscNumber),
+			TrackNumber:       int32(track.TrackNumber),
+			ArtistIds:         spotifyArtistIDs(track.Artists),
+			FeaturedArtistIds: spotifyArtistIDs(featuredArtists(track)),
+		})
+	}
+
+	return result, nil
+}
+
+// getAlbumTracks fetches all tracks of an album. The album tracks endpoint leaves out the ISRCs,
+// so the full tracks are fetched afterwards in batches.
+func getAlbumTracks(ctx context.Context, client *spotify.Client, albumID spotify.ID) ([]*spotify.FullTrack, error) {
+	page, err := client.GetAlbumTracks(ctx, albumID, spotify.Limit(50))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []spotify.ID
+	for _, track := range page.Tracks {
+		ids = append(ids, track.ID)
+	}
+
+	// Handle pagination
+	for page.Next != "" {
+		zap.L().Info("Getting next page of tracks", zap.String("next", page.Next))
+		err = client.NextPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, track := range page.Tracks {
+			ids = append(ids, track.ID)
+		}
+	}
+
+	var result []*spotify.FullTrack
+	for start := 0; start < len(ids); start += trackBatchSize {
+		end := min(start+trackBatchSize, len(ids))
+
+		tracks, err := client.GetTracks(ctx, ids[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		// Tracks Spotify doesn't know anymore are returned as nil
+		for _, track := range tracks {
+			if track != nil {
+				result = append(result, track)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// trackISRC returns the ISRC of a track, or an empty string if it has none.
+func trackISRC(track *spotify.FullTrack) string {
+	return track.ExternalIDs["isrc"]
+}
+
+// featuredArtists returns the artists of a track that aren't credited on its album.
+func featuredArtists(track *spotify.FullTrack) []spotify.SimpleArtist {
+	albumArtists := make(map[spotify.ID]bool, len(track.Album.Artists))
+	for _, artist := range track.Album.Artists {
+		albumArtists[artist.ID] = true
+	}
+
+	var featured []spotify.SimpleArtist
+	for _, artist := range track.Artists {
+		if !albumArtists[artist.ID] {
+			featured = append(featured, artist)
+		}
+	}
+	return featured
+}
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	artistsBucket = []byte("artists")
+	usersBucket   = []byte("users")
+)
+
+// BoltStore is a SyncStateStore backed by an embedded bbolt database, storing the states as JSON.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// Open opens or creates the bbolt database at path.
+func Open(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{artistsBucket, usersBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// GetArtist returns the state of an artist, or ErrNotFound if it was never synced.
+func (s *BoltStore) GetArtist(spotifyID string) (*ArtistState, error) {
+	var state ArtistState
+	err := s.get(artistsBucket, spotifyID, &state)
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// PutArtist stores the state of an artist.
+func (s *BoltStore) PutArtist(state *ArtistState) error {
+	return s.put(artistsBucket, state.SpotifyID, state)
+}
+
+// GetUser returns the state of a user, or ErrNotFound if they were never synced.
+func (s *BoltStore) GetUser(userID string) (*UserState, error) {
+	var state UserState
+	err := s.get(usersBucket, userID, &state)
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// PutUser stores the state of a user.
+func (s *BoltStore) PutUser(state *UserState) error {
+	return s.put(usersBucket, state.UserID, state)
+}
+
+// Close closes the database.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltStore) get(bucket []byte, key string, value any) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucket).Get([]byte(key))
+		if data == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(data, value)
+	})
+}
+
+func (s *BoltStore) put(bucket []byte, key string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put([]byte(key), data)
+	})
+}
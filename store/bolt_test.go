@@ -0,0 +1,111 @@
+package store
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// openTestStore opens a bolt store in a temporary directory that is closed when the test ends.
+func openTestStore(t *testing.T) *BoltStore {
+	t.Helper()
+	s, err := Open(filepath.Join(t.TempDir(), "sync-state.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestBoltStoreArtistRoundTrip(t *testing.T) {
+	s := openTestStore(t)
+
+	want := &ArtistState{
+		SpotifyID:   "spotify-artist",
+		ArtistID:    "artist",
+		ETag:        "etag",
+		AlbumIDs:    []string{"1", "2"},
+		AlbumGroups: []string{"album", "single"},
+		SyncStatus: SyncStatus{
+			LastSyncedAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			LastDuration: 2 * time.Second,
+		},
+	}
+	if err := s.PutArtist(want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.GetArtist(want.SpotifyID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetArtist = %+v, want %+v", got, want)
+	}
+}
+
+func TestBoltStoreUserRoundTrip(t *testing.T) {
+	s := openTestStore(t)
+
+	want := &UserState{
+		UserID:  "user",
+		Artists: 3,
+		SyncStatus: SyncStatus{
+			ErrorCount:  1,
+			LastError:   "spotify unavailable",
+			LastErrorAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	if err := s.PutUser(want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.GetUser(want.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetUser = %+v, want %+v", got, want)
+	}
+}
+
+func TestBoltStoreMissingKey(t *testing.T) {
+	s := openTestStore(t)
+
+	if _, err := s.GetArtist("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetArtist err = %v, want %v", err, ErrNotFound)
+	}
+	if _, err := s.GetUser("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetUser err = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestBoltStorePersistsAcrossOpens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sync-state.db")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PutArtist(&ArtistState{SpotifyID: "spotify-artist", ETag: "etag"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	got, err := s.GetArtist("spotify-artist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ETag != "etag" {
+		t.Errorf("ETag = %q, want %q", got.ETag, "etag")
+	}
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when there is no sync state for an artist or user yet.
+var ErrNotFound = errors.New("sync state not found")
+
+// SyncStatus is the outcome of the recent syncs of an artist or user.
+type SyncStatus struct {
+	LastSyncedAt time.Time     `json:"last_synced_at"`
+	LastDuration time.Duration `json:"last_duration"`
+
+	// ErrorCount is the number of failed syncs since the last successful one.
+	ErrorCount  int       `json:"error_count"`
+	LastError   string    `json:"last_error"`
+	LastErrorAt time.Time `json:"last_error_at"`
+}
+
+// Record updates the status with the outcome of a sync, err is nil if it succeeded.
+func (s *SyncStatus) Record(duration time.Duration, err error) {
+	now := time.Now()
+	s.LastDuration = duration
+	if err != nil {
+		s.ErrorCount++
+		s.LastError = err.Error()
+		s.LastErrorAt = now
+		return
+	}
+
+	s.LastSyncedAt = now
+	s.ErrorCount = 0
+	s.LastError = ""
+}
+
+// ArtistState is the sync state of a single spotify artist.
+type ArtistState struct {
+	SpotifyID string `json:"spotify_id"` // This is a spotify artist id
+	ArtistID  string `json:"artist_id"`  // This is a fanfuse artist id, empty until the artist was created
+
+	// ETag identifies the artist data as of the last successful sync, it only changes if the data changed.
+	ETag     string   `json:"etag"`
+	AlbumIDs []string `json:"album_ids"`
+	// AlbumGroups are the album groups AlbumIDs were synced with.
+	AlbumGroups []string `json:"album_groups"`
+
+	SyncStatus
+}
+
+// UserState is the sync state of a single fanfuse user.
+type UserState struct {
+	UserID  string `json:"user_id"` // This is a fanfuse user id
+	Artists int    `json:"artists"`
+
+	SyncStatus
+}
+
+// SyncStateStore persists the sync state of artists and users.
+type SyncStateStore interface {
+	// GetArtist returns the state of an artist, or ErrNotFound if it was never synced.
+	GetArtist(spotifyID string) (*ArtistState, error)
+	// PutArtist stores the state of an artist.
+	PutArtist(state *ArtistState) error
+	// GetUser returns the state of a user, or ErrNotFound if they were never synced.
+	GetUser(userID string) (*UserState, error)
+	// PutUser stores the state of a user.
+	PutUser(state *UserState) error
+	// Close releases the store.
+	Close() error
+}